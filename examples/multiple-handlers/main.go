@@ -14,11 +14,16 @@ const (
 )
 
 func main() {
-	hook := github.New(&github.Config{Secret: "MyGitHubSuperSecretSecrect...?"})
+	hook, err := github.New(github.WithSecret("MyGitHubSuperSecretSecrect...?"))
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
 	hook.RegisterEvents(HandleRelease, github.ReleaseEvent)
 	hook.RegisterEvents(HandlePullRequest, github.PullRequestEvent)
 
-	err := webhooks.Run(hook, ":"+strconv.Itoa(port), path)
+	err = webhooks.Run(hook, ":"+strconv.Itoa(port), path)
 	if err != nil {
 		fmt.Println(err)
 	}
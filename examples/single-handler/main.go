@@ -14,10 +14,15 @@ const (
 )
 
 func main() {
-	hook := github.New(&github.Config{Secret: "MyGitHubSuperSecretSecrect...?"})
+	hook, err := github.New(github.WithSecret("MyGitHubSuperSecretSecrect...?"))
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
 	hook.RegisterEvents(HandleMultiple, github.ReleaseEvent, github.PullRequestEvent) // Add as many as you want
 
-	err := webhooks.Run(hook, ":"+strconv.Itoa(port), path)
+	err = webhooks.Run(hook, ":"+strconv.Itoa(port), path)
 	if err != nil {
 		fmt.Println(err)
 	}
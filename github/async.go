@@ -0,0 +1,149 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/ntrv/webhooks"
+)
+
+const defaultQueueSize = 100
+
+// QueuePolicy defines what happens to a payload when the asynchronous queue is full
+type QueuePolicy int
+
+// available queue policies
+const (
+	// BlockWhenFull holds the request until there is room in the queue
+	BlockWhenFull QueuePolicy = iota
+	// DropWhenFull rejects the payload when there is no room in the queue
+	DropWhenFull
+)
+
+var (
+	errQueueFull   = errors.New("Payload dropped, processing queue is full")
+	errQueueClosed = errors.New("Payload dropped, webhook is closed")
+)
+
+type asyncConfig struct {
+	workers   int
+	queueSize int
+	policy    QueuePolicy
+}
+
+// WithAsync processes the decoded payloads using the given number of workers, responding
+// to GitHub as soon as a payload is queued rather than once it has been processed.
+func WithAsync(workers int) Option {
+	return func(hook *Webhook) {
+		hook.async.workers = workers
+	}
+}
+
+// WithQueueSize sets the number of payloads that can wait for a worker when
+// asynchronous processing is enabled.
+func WithQueueSize(size int) Option {
+	return func(hook *Webhook) {
+		hook.async.queueSize = size
+	}
+}
+
+// WithQueuePolicy sets what happens to a payload when the asynchronous queue is full.
+func WithQueuePolicy(policy QueuePolicy) Option {
+	return func(hook *Webhook) {
+		hook.async.policy = policy
+	}
+}
+
+type job struct {
+	log     webhooks.Logger
+	onPanic func(Event, interface{})
+	fns     []ProcessDeliveryFunc
+	payload interface{}
+	meta    DeliveryMeta
+}
+
+type workerPool struct {
+	jobs   chan job
+	policy QueuePolicy
+	wg     sync.WaitGroup
+
+	// guards closed and sending on jobs so no job is sent once it's closed
+	m      sync.RWMutex
+	closed bool
+
+	// quit is closed before the lock is taken to close jobs, so sends blocked on a full queue give up
+	quit     chan struct{}
+	quitOnce sync.Once
+}
+
+func newWorkerPool(cfg asyncConfig) *workerPool {
+	p := &workerPool{
+		jobs:   make(chan job, cfg.queueSize),
+		policy: cfg.policy,
+		quit:   make(chan struct{}),
+	}
+
+	p.wg.Add(cfg.workers)
+	for i := 0; i < cfg.workers; i++ {
+		go p.work()
+	}
+	return p
+}
+
+func (p *workerPool) work() {
+	defer p.wg.Done()
+	for j := range p.jobs {
+		// the response has already been sent, so errors can only be logged
+		_ = callProcessPayloadFuncs(j.log, j.onPanic, j.fns, j.payload, j.meta)
+	}
+}
+
+func (p *workerPool) enqueue(j job) error {
+	p.m.RLock()
+	defer p.m.RUnlock()
+
+	if p.closed {
+		return errQueueClosed
+	}
+
+	if p.policy == DropWhenFull {
+		select {
+		case p.jobs <- j:
+			return nil
+		default:
+			return errQueueFull
+		}
+	}
+
+	select {
+	case p.jobs <- j:
+		return nil
+	case <-p.quit:
+		return errQueueClosed
+	}
+}
+
+func (p *workerPool) close(ctx context.Context) error {
+	p.quitOnce.Do(func() { close(p.quit) })
+
+	p.m.Lock()
+	if !p.closed {
+		p.closed = true
+		close(p.jobs)
+	}
+	p.m.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		p.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,94 @@
+package github
+
+import (
+	"container/list"
+	"errors"
+	"sync"
+	"time"
+)
+
+// DedupStore records the delivery IDs that have already been processed so that
+// deliveries GitHub sends more than once are only processed once.
+//
+// SeenBefore must record the id as seen for at least ttl and report whether it
+// had already been recorded within that window. Forget must remove the id, it's
+// called when processing the delivery failed so that GitHub can redeliver it.
+type DedupStore interface {
+	SeenBefore(id string, ttl time.Duration) (bool, error)
+	Forget(id string) error
+}
+
+// WithDedup skips processing of any delivery whose X-GitHub-Delivery ID was already seen within ttl.
+// A delivery that isn't processed, such as one responded to with a 500 because a function failed,
+// isn't counted as seen, so its redelivery is processed.
+func WithDedup(store DedupStore, ttl time.Duration) Option {
+	return func(hook *Webhook) {
+		hook.dedup = store
+		hook.dedupTTL = ttl
+	}
+}
+
+// MemoryDedupStore is an in-memory DedupStore that keeps at most a fixed number of
+// delivery IDs, evicting the least recently seen one when full.
+type MemoryDedupStore struct {
+	capacity int
+	m        sync.Mutex
+	ll       *list.List
+	ids      map[string]*list.Element
+}
+
+type dedupEntry struct {
+	id      string
+	expires time.Time
+}
+
+// NewMemoryDedupStore returns a new MemoryDedupStore holding up to capacity delivery IDs,
+// returning an error if capacity isn't positive, as the store would then never hold any.
+func NewMemoryDedupStore(capacity int) (*MemoryDedupStore, error) {
+	if capacity <= 0 {
+		return nil, errors.New("Dedup store capacity must be positive")
+	}
+
+	return &MemoryDedupStore{
+		capacity: capacity,
+		ll:       list.New(),
+		ids:      make(map[string]*list.Element),
+	}, nil
+}
+
+// SeenBefore records the id as seen for ttl and reports whether it was already seen within its ttl.
+func (s *MemoryDedupStore) SeenBefore(id string, ttl time.Duration) (bool, error) {
+	now := time.Now()
+
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	if el, ok := s.ids[id]; ok {
+		entry := el.Value.(*dedupEntry)
+		seen := now.Before(entry.expires)
+		entry.expires = now.Add(ttl)
+		s.ll.MoveToFront(el)
+		return seen, nil
+	}
+
+	s.ids[id] = s.ll.PushFront(&dedupEntry{id: id, expires: now.Add(ttl)})
+
+	if s.ll.Len() > s.capacity {
+		oldest := s.ll.Back()
+		s.ll.Remove(oldest)
+		delete(s.ids, oldest.Value.(*dedupEntry).id)
+	}
+	return false, nil
+}
+
+// Forget removes the id, so that it's no longer reported as seen.
+func (s *MemoryDedupStore) Forget(id string) error {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	if el, ok := s.ids[id]; ok {
+		s.ll.Remove(el)
+		delete(s.ids, id)
+	}
+	return nil
+}
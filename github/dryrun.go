@@ -0,0 +1,106 @@
+package github
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+)
+
+// Signature statuses of a ValidationReport
+const (
+	SignatureValid     = "valid"
+	SignatureInvalid   = "invalid"
+	SignatureMissing   = "missing"
+	SignatureUnchecked = "unchecked"
+)
+
+// ValidationReport describes the checks a delivery passed, as responded with by Validate
+type ValidationReport struct {
+	Event Event `json:"event"`
+	// Signature is empty when the delivery failed before its signature was checked
+	Signature string `json:"signature,omitempty"`
+	Decoded   bool   `json:"decoded"`
+	Error     string `json:"error,omitempty"`
+}
+
+// Validate performs the checks ParsePayload does on a delivery, verifying its signature and decoding
+// its payload, without calling the registered functions, and always responds with a 200 and a JSON
+// ValidationReport of the outcome. It can be mounted in place of ParsePayload in staging or CI to test
+// the configuration of a hook without triggering its side effects.
+func (hook Webhook) Validate(w http.ResponseWriter, r *http.Request) {
+	report := hook.validateDelivery(r)
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(report); err != nil {
+		hook.log().Error(err.Error())
+	}
+}
+
+func (hook Webhook) validateDelivery(r *http.Request) ValidationReport {
+	w := newDiscardWriter()
+
+	var report ValidationReport
+	fail := func(err error) ValidationReport {
+		report.Error = err.Error()
+		return report
+	}
+
+	if err := hook.checkMethod(w, r); err != nil {
+		return fail(err)
+	}
+
+	if err := hook.checkUserAgent(w, r); err != nil {
+		return fail(err)
+	}
+
+	event, err := hook.getGitHubEvent(w, r)
+	if err != nil {
+		return fail(err)
+	}
+	report.Event = event
+
+	check := hook.newSignatureCheck(r)
+	payload, err := hook.readPayload(w, r, check)
+	if err != nil {
+		return fail(err)
+	}
+
+	err = hook.verifySignature(w, check)
+	switch {
+	case errors.Is(err, ErrMissingSignature):
+		report.Signature = SignatureMissing
+		return fail(err)
+	case err != nil:
+		report.Signature = SignatureInvalid
+		return fail(err)
+	case check == nil:
+		report.Signature = SignatureUnchecked
+	default:
+		report.Signature = SignatureValid
+	}
+
+	payload, err = hook.formPayload(w, r, payload)
+	if err != nil {
+		return fail(err)
+	}
+
+	// the payload of an event unknown to this package is left raw for the catch-all
+	if !event.IsValid() {
+		return report
+	}
+
+	pl, err := hook.decodePayload(event, payload)
+	if err != nil {
+		return fail(err)
+	}
+	report.Decoded = true
+
+	if err := hook.checkPayload(w, event, pl); err != nil {
+		return fail(err)
+	}
+
+	if err := hook.checkAge(w, event, pl); err != nil {
+		return fail(err)
+	}
+	return report
+}
@@ -0,0 +1,40 @@
+package github
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Errors a delivery can fail with, which the errors reported for it can be compared to using errors.Is
+var (
+	ErrMissingEvent      = errors.New("Missing event header")
+	ErrUnknownEvent      = errors.New("Unknown event")
+	ErrMissingSignature  = errors.New("Missing signature")
+	ErrSignatureMismatch = errors.New("Signature mismatch")
+	ErrEmptyPayload      = errors.New("Empty payload")
+	ErrUnregisteredEvent = errors.New("Unregistered event")
+	ErrPayloadTooOld     = errors.New("Payload too old")
+	ErrHandlerPanic      = errors.New("Handler panicked")
+	ErrRateLimited       = errors.New("Rate limited")
+	ErrInvalidPayload    = errors.New("Invalid payload")
+	ErrDuplicateHeader   = errors.New("Duplicate header")
+	ErrInvalidUserAgent  = errors.New("Invalid User-Agent")
+)
+
+// deliveryError keeps its own message, which is what GitHub is responded with, while being one of the exported errors
+type deliveryError struct {
+	msg  string
+	kind error
+}
+
+func (e *deliveryError) Error() string {
+	return e.msg
+}
+
+func (e *deliveryError) Unwrap() error {
+	return e.kind
+}
+
+func newError(kind error, format string, args ...interface{}) error {
+	return &deliveryError{msg: fmt.Sprintf(format, args...), kind: kind}
+}
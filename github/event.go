@@ -5,41 +5,84 @@ type Event string
 
 // GitHub hook types
 const (
-	CommitCommentEvent            Event = "commit_comment"
-	CreateEvent                   Event = "create"
-	DeleteEvent                   Event = "delete"
-	DeploymentEvent               Event = "deployment"
-	DeploymentStatusEvent         Event = "deployment_status"
-	ForkEvent                     Event = "fork"
-	GollumEvent                   Event = "gollum"
-	InstallationEvent             Event = "installation"
-	IntegrationInstallationEvent  Event = "integration_installation"
-	IssueCommentEvent             Event = "issue_comment"
-	IssuesEvent                   Event = "issues"
-	LabelEvent                    Event = "label"
-	MemberEvent                   Event = "member"
-	MembershipEvent               Event = "membership"
-	MilestoneEvent                Event = "milestone"
-	OrganizationEvent             Event = "organization"
-	OrgBlockEvent                 Event = "org_block"
-	PageBuildEvent                Event = "page_build"
-	PingEvent                     Event = "ping"
-	ProjectCardEvent              Event = "project_card"
-	ProjectColumnEvent            Event = "project_column"
-	ProjectEvent                  Event = "project"
-	PublicEvent                   Event = "public"
-	PullRequestEvent              Event = "pull_request"
-	PullRequestReviewEvent        Event = "pull_request_review"
-	PullRequestReviewCommentEvent Event = "pull_request_review_comment"
-	PushEvent                     Event = "push"
-	ReleaseEvent                  Event = "release"
-	RepositoryEvent               Event = "repository"
-	StatusEvent                   Event = "status"
-	TeamEvent                     Event = "team"
-	TeamAddEvent                  Event = "team_add"
-	WatchEvent                    Event = "watch"
+	BranchProtectionRuleEvent        Event = "branch_protection_rule"
+	CheckRunEvent                    Event = "check_run"
+	CheckSuiteEvent                  Event = "check_suite"
+	CommitCommentEvent               Event = "commit_comment"
+	CreateEvent                      Event = "create"
+	DeleteEvent                      Event = "delete"
+	DeploymentEvent                  Event = "deployment"
+	DeploymentProtectionRuleEvent    Event = "deployment_protection_rule"
+	DeploymentReviewEvent            Event = "deployment_review"
+	DeploymentStatusEvent            Event = "deployment_status"
+	DiscussionEvent                  Event = "discussion"
+	DiscussionCommentEvent           Event = "discussion_comment"
+	ForkEvent                        Event = "fork"
+	GithubAppAuthorizationEvent      Event = "github_app_authorization"
+	GollumEvent                      Event = "gollum"
+	InstallationEvent                Event = "installation"
+	InstallationRepositoriesEvent    Event = "installation_repositories"
+	IntegrationInstallationEvent     Event = "integration_installation"
+	IssueCommentEvent                Event = "issue_comment"
+	IssuesEvent                      Event = "issues"
+	LabelEvent                       Event = "label"
+	MemberEvent                      Event = "member"
+	MembershipEvent                  Event = "membership"
+	MergeGroupEvent                  Event = "merge_group"
+	MilestoneEvent                   Event = "milestone"
+	OrganizationEvent                Event = "organization"
+	OrgBlockEvent                    Event = "org_block"
+	PackageEvent                     Event = "package"
+	PageBuildEvent                   Event = "page_build"
+	PingEvent                        Event = "ping"
+	ProjectCardEvent                 Event = "project_card"
+	ProjectColumnEvent               Event = "project_column"
+	ProjectEvent                     Event = "project"
+	PublicEvent                      Event = "public"
+	PullRequestEvent                 Event = "pull_request"
+	PullRequestReviewEvent           Event = "pull_request_review"
+	PullRequestReviewCommentEvent    Event = "pull_request_review_comment"
+	PullRequestReviewThreadEvent     Event = "pull_request_review_thread"
+	PushEvent                        Event = "push"
+	ReleaseEvent                     Event = "release"
+	RepositoryEvent                  Event = "repository"
+	RepositoryDispatchEvent          Event = "repository_dispatch"
+	SecretScanningAlertEvent         Event = "secret_scanning_alert"
+	SecretScanningAlertLocationEvent Event = "secret_scanning_alert_location"
+	SponsorshipEvent                 Event = "sponsorship"
+	StarEvent                        Event = "star"
+	StatusEvent                      Event = "status"
+	TeamEvent                        Event = "team"
+	TeamAddEvent                     Event = "team_add"
+	WatchEvent                       Event = "watch"
+	WorkflowJobEvent                 Event = "workflow_job"
+	WorkflowRunEvent                 Event = "workflow_run"
 )
 
+// IsValid reports whether the event is a known GitHub hook event
+func (e Event) IsValid() bool {
+	_, ok := decoders[e]
+	return ok
+}
+
+// String returns the name of the event as sent in the X-GitHub-Event header
+func (e Event) String() string {
+	return string(e)
+}
+
+// MarshalText returns the name of the event, so it is encoded as a plain string by encoding/json
+// and structured loggers
+func (e Event) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText sets the event to the name in text. Names unknown to this package, such as events
+// GitHub added since, are kept as they are rather than rejected, use IsValid to check them.
+func (e *Event) UnmarshalText(text []byte) error {
+	*e = Event(text)
+	return nil
+}
+
 // EventSubtype defines a GitHub Hook Event subtype
 type EventSubtype string
 
@@ -50,4 +93,53 @@ const (
 	TagSubtype    EventSubtype = "tag"
 	PullSubtype   EventSubtype = "pull"
 	IssueSubtype  EventSubtype = "issues"
+
+	BlockedSubtype   EventSubtype = "blocked"
+	UnblockedSubtype EventSubtype = "unblocked"
 )
+
+// Subtype returns whether a branch or a tag was created
+func (p CreatePayload) Subtype() EventSubtype {
+	return refSubtype(p.RefType)
+}
+
+// Subtype returns whether a branch or a tag was deleted
+func (p DeletePayload) Subtype() EventSubtype {
+	return refSubtype(p.RefType)
+}
+
+// Subtype returns PullSubtype
+func (p PullRequestPayload) Subtype() EventSubtype {
+	return PullSubtype
+}
+
+// Subtype returns whether the user was blocked or unblocked from the organization
+func (p OrgBlockPayload) Subtype() EventSubtype {
+	switch p.Action {
+	case "blocked":
+		return BlockedSubtype
+	case "unblocked":
+		return UnblockedSubtype
+	default:
+		return NoSubtype
+	}
+}
+
+// Subtype returns whether the comment was made on a pull request or an issue
+func (p IssueCommentPayload) Subtype() EventSubtype {
+	if p.IsPullRequest() {
+		return PullSubtype
+	}
+	return IssueSubtype
+}
+
+func refSubtype(refType string) EventSubtype {
+	switch refType {
+	case "branch":
+		return BranchSubtype
+	case "tag":
+		return TagSubtype
+	default:
+		return NoSubtype
+	}
+}
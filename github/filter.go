@@ -0,0 +1,85 @@
+package github
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+)
+
+// RegisterFiltered registers the function to call when the specified event is encountered with
+// one of the given actions, payloads with any other action are acknowledged without being decoded.
+// An error is returned if the event is unknown or no action is given, use RegisterDeliveryEvents
+// to handle every action.
+func (hook Webhook) RegisterFiltered(event Event, actions []string, fn ProcessDeliveryFunc) error {
+	if err := checkEvents(event); err != nil {
+		return err
+	}
+	if len(actions) == 0 {
+		return fmt.Errorf("Webhook Event %s must be filtered on at least one action", string(event))
+	}
+
+	hook.mu.Lock()
+	defer hook.mu.Unlock()
+
+	hook.eventFuncs[event] = append(hook.eventFuncs[event], handler{
+		fn:      fn,
+		actions: append([]string{}, actions...),
+	})
+	return nil
+}
+
+// isFiltered reports whether any of the handlers is limited to some actions
+func isFiltered(handlers []handler) bool {
+	for _, h := range handlers {
+		if h.actions != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// matchHandlers returns the functions of the handlers to call for the given action
+func matchHandlers(handlers []handler, action string) []ProcessDeliveryFunc {
+	fns := make([]ProcessDeliveryFunc, 0, len(handlers))
+	for _, h := range handlers {
+		if h.matches(action) {
+			fns = append(fns, h.fn)
+		}
+	}
+	return fns
+}
+
+func (h handler) matches(action string) bool {
+	if h.actions == nil {
+		return true
+	}
+	for _, a := range h.actions {
+		if a == action {
+			return true
+		}
+	}
+	return false
+}
+
+// peekAction returns the top-level action of a raw payload without decoding the rest of it
+func peekAction(payload []byte) (string, error) {
+	var p struct {
+		Action string `json:"action"`
+	}
+	err := json.Unmarshal(payload, &p)
+	return p.Action, err
+}
+
+// payloadAction returns the action of a decoded payload, if it has one
+func payloadAction(pl interface{}) string {
+	// payloads decoded into a type registered with RegisterInto may be pointers
+	v := reflect.Indirect(reflect.ValueOf(pl))
+	if v.Kind() != reflect.Struct {
+		return ""
+	}
+	f := v.FieldByName("Action")
+	if f.Kind() != reflect.String {
+		return ""
+	}
+	return f.String()
+}
@@ -0,0 +1,40 @@
+package github
+
+import (
+	"fmt"
+	"mime"
+	"net/http"
+	"net/url"
+)
+
+// isForm reports whether the body of the request is form encoded, as GitHub sends it
+// for hooks configured with the application/x-www-form-urlencoded content type
+func isForm(r *http.Request) bool {
+	mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	return mediaType == "application/x-www-form-urlencoded"
+}
+
+// formPayload returns the JSON payload a form encoded body carries in its payload field, or the body
+// as is when it isn't form encoded. GitHub signs the body as sent, so it's called once that's verified.
+func (hook Webhook) formPayload(w http.ResponseWriter, r *http.Request, body []byte) ([]byte, error) {
+	if !isForm(r) {
+		return body, nil
+	}
+
+	form, err := url.ParseQuery(string(body))
+	if err != nil {
+		err = fmt.Errorf("Issue parsing form encoded Payload: %s", err)
+		hook.log().Error(err.Error())
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return nil, err
+	}
+
+	payload := form.Get("payload")
+	if len(payload) == 0 {
+		err := newError(ErrEmptyPayload, "Missing payload field in form encoded Payload")
+		hook.log().Error(err.Error())
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return nil, err
+	}
+	return []byte(payload), nil
+}
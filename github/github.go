@@ -1,39 +1,495 @@
 package github
 
 import (
+	"context"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
 	"github.com/ntrv/webhooks"
 )
 
+const (
+	// defaultMaxBodySize matches the maximum payload size GitHub sends
+	defaultMaxBodySize = 25 << 20
+
+	defaultEventHeader = "X-GitHub-Event"
+
+	// maxLoggedPayload is the number of bytes of a payload logged at debug level
+	maxLoggedPayload = 4 << 10
+
+	// maxSizeHint is the most allocated up front for reading a payload, whatever length it declares
+	maxSizeHint = 64 << 10
+)
+
 // Webhook instance contains all methods needed to process events
 type Webhook struct {
-	provider   webhooks.Provider
-	secret     string
-	eventFuncs map[Event]webhooks.ProcessPayloadFunc
+	provider        webhooks.Provider
+	eventHeader     string
+	signatureHeader string
+	secrets         [][]byte
+	requireSig      bool
+	requireUA       bool
+	uniformSig      bool
+	unauthorized    int
+	missingEvent    int
+	dummySecret     []byte
+	maxBody         int64
+	readTimeout     time.Duration
+	autoPong        bool
+	lazy            bool
+	strict          bool
+	stream          bool
+	catchAll        *func(Event, json.RawMessage, webhooks.Header)
+	eventFuncs      map[Event][]handler
+	targets         map[Event]func() interface{}
+	async           asyncConfig
+	pool            *workerPool
+	dedup           DedupStore
+	dedupTTL        time.Duration
+	maxAge          time.Duration
+	ageOf           func(Event, interface{}) (time.Time, bool)
+	limiter         *rateLimiter
+	validator       func(Event, interface{}) error
+	onPanic         func(Event, interface{})
+	logger          webhooks.Logger
+	logLevel        webhooks.Level
+	requestLogging  bool
+	redactKeys      []string
+	subBuffer       int
+	observer        func(Observation)
+	// timings is only set on the copy of the Webhook handling an observed delivery
+	timings *deliveryTimings
+
+	// mu guards eventFuncs, targets and catchAll, which can be registered to while payloads are parsed,
+	// it and catchAll are pointers so the copies of the Webhook the methods receive share them
+	mu *sync.RWMutex
+}
+
+// DeliveryMeta contains the metadata GitHub sends alongside each delivery
+type DeliveryMeta struct {
+	Event Event
+	// Subtype is resolved from the payload for the events that have one, such as create and delete
+	Subtype    EventSubtype
+	DeliveryID string
+	HookID     string
+	// InstallationTargetType is the kind of resource the hook is installed on,
+	// such as repository, organization or integration for GitHub Apps
+	InstallationTargetType string
+	InstallationTargetID   string
+	// EnterpriseVersion and EnterpriseHost identify the GitHub Enterprise Server instance
+	// that sent the delivery, they're empty for deliveries from github.com
+	EnterpriseVersion string
+	EnterpriseHost    string
+	Header            webhooks.Header
+
+	raw []byte
+}
+
+// RawBody returns the JSON payload exactly as GitHub sent it, for decoding fields the payload type
+// omits, archival or re-signing. For form encoded deliveries it's the payload form field, which
+// isn't what GitHub signed. It's nil when payloads are streamed, as they aren't kept in memory.
+func (m DeliveryMeta) RawBody() []byte {
+	return m.raw
+}
+
+// ProcessDeliveryFunc is a GitHub specific function for payload return values
+// that also receives the metadata of the delivery. Returning an error responds
+// with a 500 so the delivery can be retried, unless it wraps webhooks.ErrDropDelivery.
+// With WithDedup, the delivery isn't counted as seen, so its redelivery is processed.
+type ProcessDeliveryFunc func(payload interface{}, meta DeliveryMeta) error
+
+// handler is a function registered for an event, along with the actions it is limited to
+type handler struct {
+	fn ProcessDeliveryFunc
+	// actions is nil when fn is called for every action
+	actions []string
+	// sub is set when fn publishes to a subscriber, so it can be removed on its own
+	sub *subscriber
+}
+
+// Option configures optional behaviour of a GitHub Webhook instance
+type Option func(*Webhook)
+
+// WithSecret sets the secret used to verify the signature of each payload. Its bytes are the HMAC key
+// as they are, a secret stored encoded, such as in base64, has to be decoded first, see WithSecretBytes.
+func WithSecret(secret string) Option {
+	return WithSecrets(secret)
 }
 
-// Config defines the configuration to create a new GitHub Webhook instance
-type Config struct {
-	Secret string
+// WithSecretBytes sets the secret used to verify the signature of each payload to the bytes of a binary
+// secret, such as a random one decoded from base64, which are the HMAC key as they are
+func WithSecretBytes(secret []byte) Option {
+	return func(hook *Webhook) {
+		hook.secrets = nil
+		if len(secret) > 0 {
+			hook.secrets = [][]byte{append([]byte(nil), secret...)}
+		}
+	}
+}
+
+// WithSecrets sets the secrets used to verify the signature of each payload, a payload
+// signed with any of them is accepted, allowing secrets to be rotated without downtime
+func WithSecrets(secrets ...string) Option {
+	return func(hook *Webhook) {
+		hook.secrets = nil
+		for _, secret := range secrets {
+			if len(secret) > 0 {
+				hook.secrets = append(hook.secrets, []byte(secret))
+			}
+		}
+	}
+}
+
+// WithRequireSignature makes a missing secret a configuration error and rejects
+// every request without a valid signature, so the webhook fails closed.
+func WithRequireSignature(require bool) Option {
+	return func(hook *Webhook) {
+		hook.requireSig = require
+	}
+}
+
+// WithRequireGitHubUserAgent rejects requests whose User-Agent doesn't start with GitHub-Hookshot/
+// with a 403 before their payload is read, cheaply dropping scanner traffic. The User-Agent is easily
+// forged, so this doesn't replace verifying signatures.
+func WithRequireGitHubUserAgent(require bool) Option {
+	return func(hook *Webhook) {
+		hook.requireUA = require
+	}
+}
+
+// WithUniformVerification requires and verifies a signature on every request whether or not a secret
+// is set, so that it can't be told from outside. Without a secret, every request is rejected.
+func WithUniformVerification() Option {
+	return func(hook *Webhook) {
+		hook.uniformSig = true
+	}
+}
+
+// WithUnauthorizedStatus sets the status responded with when a signature is missing or doesn't match,
+// such as 401 for a firewall alerting on it. Defaults to 403.
+func WithUnauthorizedStatus(code int) Option {
+	return func(hook *Webhook) {
+		hook.unauthorized = code
+	}
+}
+
+// WithMissingEventStatus sets the status responded with when the event header is missing. Defaults to 400.
+func WithMissingEventStatus(code int) Option {
+	return func(hook *Webhook) {
+		hook.missingEvent = code
+	}
+}
+
+// WithMaxBodySize sets the maximum size in bytes of a payload, larger payloads are rejected.
+// Defaults to GitHub's own 25MB limit.
+func WithMaxBodySize(n int64) Option {
+	return func(hook *Webhook) {
+		hook.maxBody = n
+	}
+}
+
+// WithReadTimeout sets how long reading a payload may take before the request is
+// rejected with a 408, protecting against clients that send it slowly. Defaults to no timeout.
+func WithReadTimeout(d time.Duration) Option {
+	return func(hook *Webhook) {
+		hook.readTimeout = d
+	}
+}
+
+// WithAutoPong acknowledges ping events that have no registered handler,
+// rejecting them if they don't look like a ping GitHub sent.
+func WithAutoPong() Option {
+	return func(hook *Webhook) {
+		hook.autoPong = true
+	}
+}
+
+// WithLazyDecoding decodes push events into a LazyPushPayload, which leaves the commits
+// undecoded until they are needed, instead of a PushPayload.
+func WithLazyDecoding() Option {
+	return func(hook *Webhook) {
+		hook.lazy = true
+	}
+}
+
+// WithCatchAll sets fn to be called with the raw payload of any event that has no registered
+// function, including events unknown to this package, once its signature is verified.
+func WithCatchAll(fn func(event Event, raw json.RawMessage, header webhooks.Header)) Option {
+	return func(hook *Webhook) {
+		*hook.catchAll = fn
+	}
+}
+
+// WithEventHeader sets the name of the header the event is read from, for gateways
+// that rename headers. Defaults to X-GitHub-Event.
+func WithEventHeader(name string) Option {
+	return func(hook *Webhook) {
+		hook.eventHeader = name
+	}
+}
+
+// WithSignatureHeader sets the name of the only header the signature is read from,
+// for gateways that rename headers. Both sha256= and sha1= signatures are accepted in it.
+// Defaults to X-Hub-Signature-256, falling back to X-Hub-Signature.
+func WithSignatureHeader(name string) Option {
+	return func(hook *Webhook) {
+		hook.signatureHeader = name
+	}
+}
+
+// WithPanicHandler sets fn to be called with the event and the recovered value when a registered
+// function panics, after the panic has been logged along with its stack trace. The delivery is
+// responded to with a 500 either way, so GitHub can retry it.
+func WithPanicHandler(fn func(event Event, r interface{})) Option {
+	return func(hook *Webhook) {
+		hook.onPanic = fn
+	}
+}
+
+// WithLogger sets the logger used by this Webhook instance, webhooks.DefaultLog
+// is used when none is set.
+func WithLogger(logger webhooks.Logger) Option {
+	return func(hook *Webhook) {
+		hook.logger = logger
+	}
+}
+
+// WithLogLevel drops the messages logged below level, such as the payloads logged at debug level,
+// whichever logger is used.
+func WithLogLevel(level webhooks.Level) Option {
+	return func(hook *Webhook) {
+		hook.logLevel = level
+	}
 }
 
-// New creates and returns a WebHook instance denoted by the Provider type
-func New(config *Config) *Webhook {
-	return &Webhook{
-		provider:   webhooks.GitHub,
-		secret:     config.Secret,
-		eventFuncs: map[Event]webhooks.ProcessPayloadFunc{},
+// WithRequestLogging logs the messages every delivery logs as it is parsed, such as "Parsing Payload..."
+// and "Checking secret", at info level rather than debug level, which floods the log at high volume.
+func WithRequestLogging(enabled bool) Option {
+	return func(hook *Webhook) {
+		hook.requestLogging = enabled
 	}
 }
 
+// New creates and returns a WebHook instance denoted by the Provider type,
+// returning an error if the given options are not valid together
+func New(opts ...Option) (*Webhook, error) {
+	hook := &Webhook{
+		provider:     webhooks.GitHub,
+		eventHeader:  defaultEventHeader,
+		mu:           new(sync.RWMutex),
+		eventFuncs:   map[Event][]handler{},
+		catchAll:     new(func(Event, json.RawMessage, webhooks.Header)),
+		unauthorized: http.StatusForbidden,
+		missingEvent: http.StatusBadRequest,
+		targets:      map[Event]func() interface{}{},
+		maxBody:      defaultMaxBodySize,
+		subBuffer:    defaultSubscriberBuffer,
+		async: asyncConfig{
+			queueSize: defaultQueueSize,
+			policy:    BlockWhenFull,
+		},
+	}
+
+	for _, opt := range opts {
+		opt(hook)
+	}
+
+	if err := hook.validate(); err != nil {
+		return nil, err
+	}
+
+	if hook.logLevel > webhooks.LevelDebug {
+		hook.logger = webhooks.WithLevel(hook.log(), hook.logLevel)
+	}
+
+	if hook.uniformSig && len(hook.secrets) == 0 {
+		hook.dummySecret = make([]byte, 32)
+		if _, err := rand.Read(hook.dummySecret); err != nil {
+			return nil, err
+		}
+	}
+
+	if hook.async.workers > 0 {
+		hook.pool = newWorkerPool(hook.async)
+	}
+
+	return hook, nil
+}
+
+// log returns the logger of this Webhook instance, falling back to webhooks.DefaultLog
+func (hook Webhook) log() webhooks.Logger {
+	if hook.logger != nil {
+		return hook.logger
+	}
+	return webhooks.DefaultLog
+}
+
+// logRequest logs the message every delivery logs as it is parsed, which is only logged at info level
+// with WithRequestLogging
+func (hook Webhook) logRequest(msg string) {
+	if hook.requestLogging {
+		hook.log().Info(msg)
+		return
+	}
+	hook.log().Debug(msg)
+}
+
+func (hook Webhook) validate() error {
+	if len(hook.eventHeader) == 0 {
+		return errors.New("Event header name must not be empty")
+	}
+	if hook.requireSig && len(hook.secrets) == 0 {
+		return errors.New("Secret is required when signature verification is required")
+	}
+	if !isErrorStatus(hook.unauthorized) || !isErrorStatus(hook.missingEvent) {
+		return errors.New("Failure status must be a client or server error")
+	}
+	if hook.maxBody <= 0 {
+		return errors.New("Maximum body size must be positive")
+	}
+	if hook.readTimeout < 0 {
+		return errors.New("Read timeout must not be negative")
+	}
+	if hook.subBuffer <= 0 {
+		return errors.New("Subscriber buffer size must be positive")
+	}
+	if hook.async.workers < 0 {
+		return errors.New("Number of async workers must not be negative")
+	}
+	if hook.async.queueSize < 0 {
+		return errors.New("Async queue size must not be negative")
+	}
+	if hook.dedup != nil && hook.dedupTTL <= 0 {
+		return errors.New("Dedup ttl must be positive")
+	}
+	if hook.ageOf != nil && hook.maxAge <= 0 {
+		return errors.New("Maximum age must be positive")
+	}
+	if hook.limiter != nil && (hook.limiter.rate <= 0 || hook.limiter.burst < 1) {
+		return errors.New("Rate limit and burst must be positive")
+	}
+	return nil
+}
+
+func isErrorStatus(code int) bool {
+	return code >= 400 && code <= 599
+}
+
 // Provider returns the current hooks provider ID
 func (hook Webhook) Provider() webhooks.Provider {
 	return hook.provider
 }
 
-// RegisterEvents registers the function to call when the specified event(s) are encountered
-func (hook Webhook) RegisterEvents(fn webhooks.ProcessPayloadFunc, events ...Event) {
+// RegisterEvents registers the function to call when the specified event(s) are encountered,
+// in addition to any function already registered for them, returning an error without
+// registering it for any of them if one of the events is unknown
+func (hook Webhook) RegisterEvents(fn webhooks.ProcessPayloadFunc, events ...Event) error {
+	return hook.RegisterDeliveryEvents(func(payload interface{}, meta DeliveryMeta) error {
+		fn(payload, meta.Header)
+		return nil
+	}, events...)
+}
+
+// RegisterDeliveryEvents registers the function to call, along with the delivery metadata,
+// when the specified event(s) are encountered, in addition to any function already registered for them,
+// returning an error without registering it for any of them if one of the events is unknown
+func (hook Webhook) RegisterDeliveryEvents(fn ProcessDeliveryFunc, events ...Event) error {
+	if err := checkEvents(events...); err != nil {
+		return err
+	}
+
+	hook.mu.Lock()
+	defer hook.mu.Unlock()
 
 	for _, event := range events {
-		hook.eventFuncs[event] = fn
+		hook.eventFuncs[event] = append(hook.eventFuncs[event], handler{fn: fn})
+	}
+	return nil
+}
+
+// Deregister removes every function registered for the specified event(s), along with the types
+// registered for them with RegisterInto, so they are handled as if never registered. The channels
+// of their subscribers are closed.
+func (hook Webhook) Deregister(events ...Event) {
+	hook.mu.Lock()
+	defer hook.mu.Unlock()
+
+	for _, event := range events {
+		for _, h := range hook.eventFuncs[event] {
+			if h.sub != nil {
+				h.sub.close()
+			}
+		}
+		delete(hook.eventFuncs, event)
+		delete(hook.targets, event)
+	}
+}
+
+// DefaultPayload is the payload the function registered with RegisterDefault is called with
+type DefaultPayload struct {
+	Event Event
+	Raw   json.RawMessage
+}
+
+// RegisterDefault registers the function to call with a DefaultPayload for any event that has no
+// registered function, including events unknown to this package, once its signature is verified.
+// It replaces the catch-all set with WithCatchAll, if any.
+func (hook Webhook) RegisterDefault(fn webhooks.ProcessPayloadFunc) {
+	hook.mu.Lock()
+	defer hook.mu.Unlock()
+
+	*hook.catchAll = func(event Event, raw json.RawMessage, header webhooks.Header) {
+		fn(DefaultPayload{Event: event, Raw: raw}, header)
+	}
+}
+
+// HasDefault reports whether events without a registered function are handled,
+// by a function registered with RegisterDefault or set with WithCatchAll.
+func (hook Webhook) HasDefault() bool {
+	return hook.getCatchAll() != nil
+}
+
+// getCatchAll returns the function to call for events without a registered function, if any
+func (hook Webhook) getCatchAll() func(Event, json.RawMessage, webhooks.Header) {
+	hook.mu.RLock()
+	defer hook.mu.RUnlock()
+
+	return *hook.catchAll
+}
+
+// RegisteredEvents returns the events functions are registered for, sorted by name
+func (hook Webhook) RegisteredEvents() []Event {
+	hook.mu.RLock()
+	defer hook.mu.RUnlock()
+
+	events := make([]Event, 0, len(hook.eventFuncs))
+	for event := range hook.eventFuncs {
+		events = append(events, event)
+	}
+	sort.Slice(events, func(i, j int) bool { return events[i] < events[j] })
+	return events
+}
+
+// checkEvents returns an error if any of the events is not a known GitHub hook event
+func checkEvents(events ...Event) error {
+	for _, event := range events {
+		if !event.IsValid() {
+			return newError(ErrUnknownEvent, "Unknown Webhook Event %s", string(event))
+		}
+	}
+	return nil
+}
+
+// Close stops accepting new payloads and waits for the in-flight ones to be processed
+// when asynchronous processing is enabled, or until the context is done.
+func (hook Webhook) Close(ctx context.Context) error {
+	if hook.pool == nil {
+		return nil
 	}
+	return hook.pool.close(ctx)
 }
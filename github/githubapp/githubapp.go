@@ -0,0 +1,156 @@
+// Package githubapp authenticates as a GitHub App, so installations can be acted on
+// from the handlers of their events.
+package githubapp
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const defaultBaseURL = "https://api.github.com"
+
+// AppAuth mints the tokens a GitHub App authenticates to the API with
+type AppAuth struct {
+	appID   int64
+	key     *rsa.PrivateKey
+	baseURL string
+	client  *http.Client
+	now     func() time.Time
+}
+
+// Option configures optional behaviour of an AppAuth instance
+type Option func(*AppAuth)
+
+// WithBaseURL sets the URL of the API, for GitHub Enterprise Server. Defaults to https://api.github.com.
+func WithBaseURL(url string) Option {
+	return func(a *AppAuth) {
+		a.baseURL = strings.TrimSuffix(url, "/")
+	}
+}
+
+// WithHTTPClient sets the client used to call the API. Defaults to http.DefaultClient.
+func WithHTTPClient(client *http.Client) Option {
+	return func(a *AppAuth) {
+		a.client = client
+	}
+}
+
+// NewAppAuth creates and returns an AppAuth instance for the App with the given ID, using its
+// PEM encoded private key, returning an error if the key can't be parsed
+func NewAppAuth(appID int64, privateKey []byte, opts ...Option) (*AppAuth, error) {
+	key, err := parsePrivateKey(privateKey)
+	if err != nil {
+		return nil, err
+	}
+
+	a := &AppAuth{
+		appID:   appID,
+		key:     key,
+		baseURL: defaultBaseURL,
+		client:  http.DefaultClient,
+		now:     time.Now,
+	}
+
+	for _, opt := range opts {
+		opt(a)
+	}
+	return a, nil
+}
+
+func parsePrivateKey(privateKey []byte) (*rsa.PrivateKey, error) {
+	block, _ := pem.Decode(privateKey)
+	if block == nil {
+		return nil, errors.New("Private key is not PEM encoded")
+	}
+
+	// GitHub hands out PKCS #1 keys, but converted PKCS #8 ones are accepted too
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("Issue parsing private key: %s", err)
+	}
+	rsaKey, ok := key.(*rsa.PrivateKey)
+	if !ok {
+		return nil, errors.New("Private key is not an RSA key")
+	}
+	return rsaKey, nil
+}
+
+// JWT returns a JSON Web Token authenticating as the App itself, valid for 10 minutes
+func (a *AppAuth) JWT() (string, error) {
+	// issued in the past to allow for clock drift, as GitHub recommends
+	now := a.now()
+	claims, err := json.Marshal(map[string]interface{}{
+		"iat": now.Add(-time.Minute).Unix(),
+		"exp": now.Add(9 * time.Minute).Unix(),
+		"iss": strconv.FormatInt(a.appID, 10),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	enc := base64.RawURLEncoding
+	unsigned := enc.EncodeToString([]byte(`{"alg":"RS256","typ":"JWT"}`)) + "." + enc.EncodeToString(claims)
+
+	digest := sha256.Sum256([]byte(unsigned))
+	sig, err := rsa.SignPKCS1v15(rand.Reader, a.key, crypto.SHA256, digest[:])
+	if err != nil {
+		return "", err
+	}
+	return unsigned + "." + enc.EncodeToString(sig), nil
+}
+
+// InstallationToken mints an access token for the installation with the given ID, such as the one
+// in the payload of an installation event, with which the App can act on the installation.
+func (a *AppAuth) InstallationToken(ctx context.Context, installationID int64) (string, error) {
+	jwt, err := a.JWT()
+	if err != nil {
+		return "", err
+	}
+
+	url := fmt.Sprintf("%s/app/installations/%d/access_tokens", a.baseURL, installationID)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, nil)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Accept", "application/vnd.github+json")
+	req.Header.Set("Authorization", "Bearer "+jwt)
+
+	resp, err := a.client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return "", err
+	}
+	if resp.StatusCode != http.StatusCreated {
+		return "", fmt.Errorf("Issue creating token for installation %d: %s %s", installationID, resp.Status, string(body))
+	}
+
+	var token struct {
+		Token string `json:"token"`
+	}
+	if err = json.Unmarshal(body, &token); err != nil {
+		return "", fmt.Errorf("Issue decoding token for installation %d: %s", installationID, err)
+	}
+	return token.Token, nil
+}
@@ -0,0 +1,102 @@
+package githubapp
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"sort"
+
+	"github.com/ntrv/webhooks"
+	"github.com/ntrv/webhooks/github"
+)
+
+// Drift contains the differences between the events a Webhook has functions registered for
+// and the events its hook on GitHub is subscribed to
+type Drift struct {
+	// Unsent are registered, but GitHub won't send them so their functions are never called
+	Unsent []github.Event
+	// Unhandled are sent by GitHub, but have no functions registered so their deliveries are dropped,
+	// there are none when the Webhook has a default function
+	Unhandled []github.Event
+}
+
+// VerifyAgainstGitHub fetches the events the hook at hookURL is subscribed to and compares them to
+// the events registered with hook, logging each difference as an error to logger, or webhooks.DefaultLog
+// if it's nil, so that mistakes can be caught at startup rather than when deliveries arrive. hookURL is
+// the API URL of the hook, such as https://api.github.com/repos/octocat/Hello-World/hooks/12345, which
+// is sent as hook.url in its ping event, and client has to authenticate with read access to it, such as
+// with an installation token minted with InstallationToken.
+func VerifyAgainstGitHub(ctx context.Context, client *http.Client, hookURL string, hook *github.Webhook, logger webhooks.Logger) (Drift, error) {
+	if logger == nil {
+		logger = webhooks.DefaultLog
+	}
+
+	subscribed, err := hookEvents(ctx, client, hookURL)
+	if err != nil {
+		return Drift{}, err
+	}
+
+	var drift Drift
+	registered := make(map[github.Event]bool)
+	for _, event := range hook.RegisteredEvents() {
+		registered[event] = true
+
+		// ping is sent when the hook is created regardless of its events
+		if event == github.PingEvent || subscribed[event] || subscribed["*"] {
+			continue
+		}
+		drift.Unsent = append(drift.Unsent, event)
+		logger.Error(fmt.Sprintf("Webhook Event %s is registered but GitHub won't send it", string(event)))
+	}
+
+	for event := range subscribed {
+		// the default function handles every event without a registered one
+		if event == "*" || registered[event] || hook.HasDefault() {
+			continue
+		}
+		drift.Unhandled = append(drift.Unhandled, event)
+	}
+	sort.Slice(drift.Unhandled, func(i, j int) bool { return drift.Unhandled[i] < drift.Unhandled[j] })
+	for _, event := range drift.Unhandled {
+		logger.Error(fmt.Sprintf("Webhook Event %s is sent by GitHub but not registered", string(event)))
+	}
+	return drift, nil
+}
+
+// hookEvents fetches the events the hook at hookURL is subscribed to
+func hookEvents(ctx context.Context, client *http.Client, hookURL string) (map[github.Event]bool, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, hookURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/vnd.github+json")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("Issue fetching hook %s: %s %s", hookURL, resp.Status, string(body))
+	}
+
+	var config struct {
+		Events []github.Event `json:"events"`
+	}
+	if err = json.Unmarshal(body, &config); err != nil {
+		return nil, fmt.Errorf("Issue decoding hook %s: %s", hookURL, err)
+	}
+
+	events := make(map[github.Event]bool, len(config.Events))
+	for _, event := range config.Events {
+		events[event] = true
+	}
+	return events, nil
+}
@@ -0,0 +1,28 @@
+//go:build echo
+
+// Package githubecho adapts the github package to Echo. It's only built with the echo build tag,
+// so the github package doesn't depend on Echo:
+//
+//	go build -tags echo
+package githubecho
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/ntrv/webhooks/github"
+)
+
+// Handler returns an echo.HandlerFunc handling the deliveries of hook, such as for e.POST("/webhooks", ...).
+// The response is written by hook, so the error returned is always nil.
+func Handler(hook *github.Webhook) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		hook.ParsePayload(FromEcho(c))
+		return nil
+	}
+}
+
+// FromEcho returns the response writer and request of c, for passing to ParsePayload, Parse or Validate
+func FromEcho(c echo.Context) (http.ResponseWriter, *http.Request) {
+	return c.Response(), c.Request()
+}
@@ -0,0 +1,26 @@
+//go:build gin
+
+// Package githubgin adapts the github package to Gin. It's only built with the gin build tag,
+// so the github package doesn't depend on Gin:
+//
+//	go build -tags gin
+package githubgin
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/ntrv/webhooks/github"
+)
+
+// Handler returns a gin.HandlerFunc handling the deliveries of hook, such as for router.POST("/webhooks", ...)
+func Handler(hook *github.Webhook) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		hook.ParsePayload(FromGin(c))
+	}
+}
+
+// FromGin returns the response writer and request of c, for passing to ParsePayload, Parse or Validate
+func FromGin(c *gin.Context) (http.ResponseWriter, *http.Request) {
+	return c.Writer, c.Request
+}
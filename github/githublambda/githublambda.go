@@ -0,0 +1,41 @@
+// Package githublambda parses GitHub deliveries received through AWS Lambda behind API Gateway,
+// which hands over the request as its headers and body rather than an *http.Request. It doesn't
+// depend on the AWS SDK, the fields of an events.APIGatewayProxyRequest are passed as they are.
+package githublambda
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/ntrv/webhooks/github"
+)
+
+// ParseLambda verifies and decodes the delivery with the given headers and body as hook.Parse does,
+// leaving calling the registered functions and responding to the caller. API Gateway base64 encodes
+// bodies it considers binary, which isBase64 reports, and may change the case of header names, so
+// they are matched case-insensitively.
+func ParseLambda(hook *github.Webhook, headers map[string]string, body string, isBase64 bool) (github.Event, interface{}, error) {
+	payload := []byte(body)
+	if isBase64 {
+		var err error
+		if payload, err = base64.StdEncoding.DecodeString(body); err != nil {
+			return "", nil, fmt.Errorf("Issue decoding base64 Payload: %s", err)
+		}
+	}
+
+	header := make(http.Header, len(headers))
+	for name, value := range headers {
+		header.Set(name, value)
+	}
+
+	r := &http.Request{
+		Method:        http.MethodPost,
+		Header:        header,
+		Body:          ioutil.NopCloser(bytes.NewReader(payload)),
+		ContentLength: int64(len(payload)),
+	}
+	return hook.Parse(r)
+}
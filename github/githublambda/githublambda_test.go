@@ -0,0 +1,38 @@
+package githublambda
+
+import (
+	"encoding/base64"
+	"errors"
+	"testing"
+
+	. "gopkg.in/go-playground/assert.v1"
+	"gopkg.in/go-playground/webhooks.v3/github"
+)
+
+func TestParseLambda(t *testing.T) {
+	hook, err := github.New(github.WithSecret("IsWishesWereHorsesWedAllBeEatingSteak!"))
+	Equal(t, err, nil)
+
+	// API Gateway may lower case the header names
+	headers := map[string]string{
+		"content-type":        "application/json",
+		"x-github-event":      "push",
+		"x-hub-signature-256": "sha256=cde2ef7eb3b782918ac737dec36061b7b50b031f7ee7f99e46b367e29c156684",
+	}
+
+	event, pl, err := ParseLambda(hook, headers, "{}", false)
+	Equal(t, err, nil)
+	Equal(t, event, github.PushEvent)
+	Equal(t, pl, github.PushPayload{})
+
+	event, pl, err = ParseLambda(hook, headers, base64.StdEncoding.EncodeToString([]byte("{}")), true)
+	Equal(t, err, nil)
+	Equal(t, event, github.PushEvent)
+	Equal(t, pl, github.PushPayload{})
+
+	_, _, err = ParseLambda(hook, headers, "{", false)
+	Equal(t, errors.Is(err, github.ErrSignatureMismatch), true)
+
+	_, _, err = ParseLambda(hook, headers, "not base64!", true)
+	NotEqual(t, err, nil)
+}
@@ -0,0 +1,65 @@
+// Package githubtest provides helpers for testing code built on the github package.
+package githubtest
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/ntrv/webhooks/github"
+)
+
+// NewSignedRequest returns a POST request for the event carrying every header GitHub sends,
+// with body signed using secret in both X-Hub-Signature and X-Hub-Signature-256.
+func NewSignedRequest(event github.Event, body []byte, secret string) *http.Request {
+	req, err := http.NewRequest(http.MethodPost, "/webhooks", bytes.NewReader(body))
+	if err != nil {
+		panic(err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "GitHub-Hookshot/githubtest")
+	req.Header.Set("X-GitHub-Event", string(event))
+	req.Header.Set("X-GitHub-Delivery", newDeliveryID())
+	req.Header.Set("X-Hub-Signature", "sha1="+sign(sha1.New, body, secret))
+	req.Header.Set("X-Hub-Signature-256", "sha256="+sign(sha256.New, body, secret))
+	return req
+}
+
+// MustPayload reads the payload fixture at filename, panicking if it can't be
+// read or doesn't decode as the payload of the event.
+func MustPayload(event github.Event, filename string) []byte {
+	payload, err := ioutil.ReadFile(filename)
+	if err != nil {
+		panic(err)
+	}
+
+	if _, err := github.ParsePayloadBytes(event, payload); err != nil {
+		panic(fmt.Sprintf("fixture %s is not a valid %s payload: %s", filename, string(event), err))
+	}
+	return payload
+}
+
+func sign(hashFn func() hash.Hash, body []byte, secret string) string {
+	mac := hmac.New(hashFn, []byte(secret))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// newDeliveryID returns a random version 4 UUID like the ones GitHub uses for delivery IDs
+func newDeliveryID() string {
+	var b [16]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		panic(err)
+	}
+	b[6] = (b[6] & 0x0f) | 0x40
+	b[8] = (b[8] & 0x3f) | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:])
+}
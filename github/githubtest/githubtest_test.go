@@ -0,0 +1,38 @@
+package githubtest
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	. "gopkg.in/go-playground/assert.v1"
+	"gopkg.in/go-playground/webhooks.v3"
+	"gopkg.in/go-playground/webhooks.v3/github"
+)
+
+func TestNewSignedRequest(t *testing.T) {
+	var ref string
+
+	hook, err := github.New(github.WithSecret("secret"), github.WithRequireSignature(true))
+	Equal(t, err, nil)
+	hook.RegisterEvents(func(payload interface{}, header webhooks.Header) {
+		ref = payload.(github.PushPayload).Ref
+	}, github.PushEvent)
+
+	req := NewSignedRequest(github.PushEvent, MustPayload(github.PushEvent, "testdata/push.json"), "secret")
+	NotEqual(t, req.Header.Get("X-GitHub-Delivery"), "")
+
+	w := httptest.NewRecorder()
+	hook.ParsePayload(w, req)
+
+	Equal(t, w.Code, http.StatusOK)
+	Equal(t, ref, "refs/heads/master")
+
+	w = httptest.NewRecorder()
+	hook.ParsePayload(w, NewSignedRequest(github.PushEvent, []byte("{}"), "wrong"))
+	Equal(t, w.Code, http.StatusForbidden)
+}
+
+func TestMustPayload(t *testing.T) {
+	PanicMatches(t, func() { MustPayload(github.PushEvent, "testdata/missing.json") }, "open testdata/missing.json: no such file or directory")
+}
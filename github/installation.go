@@ -0,0 +1,33 @@
+package github
+
+import "reflect"
+
+// Installation is the GitHub App installation a payload was delivered for, which GitHub only sends
+// in deliveries to Apps
+type Installation struct {
+	ID int64 `json:"id"`
+}
+
+// InstallationID returns the ID of the GitHub App installation a decoded payload was delivered for,
+// taken from its installation field, or false if it has none, such as for deliveries to repository
+// and organization webhooks. It works with every payload type of an event GitHub sends to Apps, and
+// any type registered with RegisterInto with such a field, so installation tokens can be looked up
+// without switching on the type.
+func InstallationID(payload interface{}) (int64, bool) {
+	v := reflect.Indirect(reflect.ValueOf(payload))
+	if v.Kind() != reflect.Struct {
+		return 0, false
+	}
+
+	installation := reflect.Indirect(v.FieldByName("Installation"))
+	if installation.Kind() != reflect.Struct {
+		return 0, false
+	}
+
+	id := installation.FieldByName("ID")
+	switch id.Kind() {
+	case reflect.Int, reflect.Int32, reflect.Int64:
+		return id.Int(), id.Int() != 0
+	}
+	return 0, false
+}
@@ -0,0 +1,35 @@
+package github
+
+import (
+	"net/http"
+	"time"
+)
+
+// WithMaxAge rejects deliveries whose payload is older than d with a 400, guarding against captured
+// payloads being replayed. extractor returns the time the payload of the event was created at, or
+// false for payloads without a reliable one, which skips the check.
+func WithMaxAge(d time.Duration, extractor func(event Event, payload interface{}) (time.Time, bool)) Option {
+	return func(hook *Webhook) {
+		hook.maxAge = d
+		hook.ageOf = extractor
+	}
+}
+
+// checkAge responds with a 400 and returns an error if the decoded payload is older than the maximum age
+func (hook Webhook) checkAge(w http.ResponseWriter, event Event, pl interface{}) error {
+	if hook.ageOf == nil {
+		return nil
+	}
+
+	created, ok := hook.ageOf(event, pl)
+	if !ok {
+		return nil
+	}
+
+	if age := time.Since(created); age > hook.maxAge {
+		err := newError(ErrPayloadTooOld, "Webhook Event %s payload is %s old, the maximum is %s", string(event), age.Round(time.Second), hook.maxAge)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return err
+	}
+	return nil
+}
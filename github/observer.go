@@ -0,0 +1,90 @@
+package github
+
+import (
+	"net/http"
+	"time"
+)
+
+// Outcome describes how a delivery was handled
+type Outcome string
+
+// Delivery outcomes reported to an observer
+const (
+	OutcomeProcessed      Outcome = "processed"
+	OutcomeUnregistered   Outcome = "unregistered"
+	OutcomeFiltered       Outcome = "filtered"
+	OutcomeDuplicate      Outcome = "duplicate"
+	OutcomeInvalidMethod  Outcome = "invalid_method"
+	OutcomeBadUserAgent   Outcome = "bad_user_agent"
+	OutcomeInvalidEvent   Outcome = "invalid_event"
+	OutcomeReadError      Outcome = "read_error"
+	OutcomeSignatureError Outcome = "signature_error"
+	OutcomeDecodeError    Outcome = "decode_error"
+	OutcomeInvalidPayload Outcome = "invalid_payload"
+	OutcomeTooOld         Outcome = "too_old"
+	OutcomeRateLimited    Outcome = "rate_limited"
+	OutcomeHandlerError   Outcome = "handler_error"
+	OutcomeQueueError     Outcome = "queue_error"
+)
+
+// Observation describes a single call to ParsePayload, for use in metrics
+type Observation struct {
+	// Event is the X-GitHub-Event header value, which may not be a valid Event
+	Event    Event
+	Status   int
+	Duration time.Duration
+	Outcome  Outcome
+	// Err is the reason the delivery wasn't processed, if any, which can be compared
+	// to the exported errors using errors.Is
+	Err error
+	// DecodeDuration is the part of Duration spent decoding the payload, which includes reading
+	// it when payloads are streamed, and HandlerDuration the part spent in the registered functions,
+	// or queueing for them when they are called asynchronously
+	DecodeDuration  time.Duration
+	HandlerDuration time.Duration
+}
+
+// WithObserver sets fn to be called after every call to ParsePayload, whether
+// the delivery was processed or not, with the response status and time taken.
+// Asynchronous deliveries are observed once queued.
+func WithObserver(fn func(Observation)) Option {
+	return func(hook *Webhook) {
+		hook.observer = fn
+	}
+}
+
+// deliveryTimings records where the time taken by a delivery went, for the observer
+type deliveryTimings struct {
+	decode  time.Duration
+	handler time.Duration
+}
+
+// recordDecode adds the time since start to the time spent decoding, when observed
+func (hook Webhook) recordDecode(start time.Time) {
+	if hook.timings != nil {
+		hook.timings.decode += time.Since(start)
+	}
+}
+
+// recordHandler adds the time since start to the time spent in the registered functions, when observed
+func (hook Webhook) recordHandler(start time.Time) {
+	if hook.timings != nil {
+		hook.timings.handler += time.Since(start)
+	}
+}
+
+// statusWriter records the status code written to the underlying http.ResponseWriter
+type statusWriter struct {
+	http.ResponseWriter
+	status int
+}
+
+func (w *statusWriter) WriteHeader(status int) {
+	w.status = status
+	w.ResponseWriter.WriteHeader(status)
+}
+
+// Unwrap returns the underlying http.ResponseWriter, for use by http.ResponseController
+func (w *statusWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
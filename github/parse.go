@@ -1,244 +1,918 @@
 package github
 
 import (
+	"bytes"
+	"compress/gzip"
 	"crypto/hmac"
 	"crypto/sha1"
+	"crypto/sha256"
 	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"hash"
+	"io"
 	"io/ioutil"
+	"net"
 	"net/http"
+	"os"
+	"reflect"
+	"runtime/debug"
+	"strings"
+	"time"
 
 	"github.com/ntrv/webhooks"
 )
 
+// checkMethod rejects anything but the POST GitHub delivers webhooks with
+func (hook Webhook) checkMethod(w http.ResponseWriter, r *http.Request) error {
+	if r.Method != http.MethodPost {
+		err := fmt.Errorf("Method %s not allowed", r.Method)
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, err.Error(), http.StatusMethodNotAllowed)
+		return err
+	}
+	return nil
+}
+
+// checkUserAgent rejects requests not sent by GitHub's webhook service, when required
+func (hook Webhook) checkUserAgent(w http.ResponseWriter, r *http.Request) error {
+	if !hook.requireUA || strings.HasPrefix(r.Header.Get("User-Agent"), "GitHub-Hookshot/") {
+		return nil
+	}
+
+	err := newError(ErrInvalidUserAgent, "User-Agent %q not allowed", r.Header.Get("User-Agent"))
+	http.Error(w, err.Error(), http.StatusForbidden)
+	return err
+}
+
 func (hook Webhook) getGitHubEvent(w http.ResponseWriter, r *http.Request) (Event, error) {
-	webhooks.DefaultLog.Info("Parsing Payload...")
+	hook.logRequest("Parsing Payload...")
 
-	event := r.Header.Get("X-GitHub-Event")
+	// a proxy could have let a second value be injected, which Get would silently ignore
+	if len(r.Header.Values(hook.eventHeader)) > 1 {
+		err := newError(ErrDuplicateHeader, "Multiple %s Headers", hook.eventHeader)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return "", err
+	}
+
+	event := r.Header.Get(hook.eventHeader)
 	if len(event) == 0 {
-		err := errors.New("Missing X-GitHub-Event Header")
+		err := newError(ErrMissingEvent, "Missing %s Header", hook.eventHeader)
+		http.Error(w, err.Error(), hook.missingEvent)
+		return "", err
+	}
+	hook.log().Debug(fmt.Sprintf("%s:%s", hook.eventHeader, event))
+
+	// unknown events are let through when a catch-all can handle them
+	if !Event(event).IsValid() && hook.getCatchAll() == nil {
+		err := newError(ErrUnknownEvent, "Unknown %s Header value %s", hook.eventHeader, event)
 		http.Error(w, err.Error(), http.StatusBadRequest)
-		return nil, err
+		return "", err
 	}
-	webhooks.DefaultLog.Debug(fmt.Sprintf("X-GitHub-Event:%s", event))
 	return Event(event), nil
 }
 
-func (hook Webhook) verifySignature(w http.ResponseWriter, r *http.Request) error {
-	// If we have a Secret set, we should check the MAC
-	if len(hook.secret) > 0 {
-		webhooks.DefaultLog.Info("Checking secret")
-		signature := r.Header.Get("X-Hub-Signature")
-		if len(signature) == 0 {
-			err := errors.New("Missing X-Hub-Signature required for HMAC verification")
-			webhooks.DefaultLog.Error(err.Error())
-			http.Error(w, err.Error(), http.StatusForbidden)
-			return err
+func (hook Webhook) getDeliveryMeta(event Event, r *http.Request) DeliveryMeta {
+	meta := DeliveryMeta{
+		Event:      event,
+		DeliveryID: r.Header.Get("X-GitHub-Delivery"),
+		HookID:     r.Header.Get("X-GitHub-Hook-ID"),
+
+		InstallationTargetType: r.Header.Get("X-GitHub-Hook-Installation-Target-Type"),
+		InstallationTargetID:   r.Header.Get("X-GitHub-Hook-Installation-Target-ID"),
+
+		EnterpriseVersion: r.Header.Get("X-GitHub-Enterprise-Version"),
+		EnterpriseHost:    r.Header.Get("X-GitHub-Enterprise-Host"),
+
+		Header: webhooks.Header(r.Header),
+	}
+	hook.log().Debug(fmt.Sprintf("X-GitHub-Delivery:%s", meta.DeliveryID))
+	return meta
+}
+
+// isDuplicate reports whether the delivery was already seen, failing open if the store errors
+func (hook Webhook) isDuplicate(meta DeliveryMeta) bool {
+	if hook.dedup == nil || len(meta.DeliveryID) == 0 {
+		return false
+	}
+
+	seen, err := hook.dedup.SeenBefore(meta.DeliveryID, hook.dedupTTL)
+	if err != nil {
+		hook.log().Error(fmt.Sprintf("Issue checking delivery %s for duplicates: %s", meta.DeliveryID, err))
+		return false
+	}
+	if seen {
+		hook.logRequest(fmt.Sprintf("Delivery %s already processed, skipping", meta.DeliveryID))
+	}
+	return seen
+}
+
+// forgetDelivery removes the delivery from the dedup store when processing it failed,
+// so its redelivery isn't skipped as a duplicate
+func (hook Webhook) forgetDelivery(meta DeliveryMeta) {
+	if hook.dedup == nil || len(meta.DeliveryID) == 0 {
+		return
+	}
+
+	if err := hook.dedup.Forget(meta.DeliveryID); err != nil {
+		hook.log().Error(fmt.Sprintf("Issue forgetting delivery %s: %s", meta.DeliveryID, err))
+	}
+}
+
+// getSignature returns the signature header to verify along with its value and the algorithm it uses
+func (hook Webhook) getSignature(r *http.Request) (header, signature, algorithm string, hashFn func() hash.Hash) {
+	// a custom header may carry either signature, so the algorithm is taken from its value
+	if len(hook.signatureHeader) > 0 {
+		signature = r.Header.Get(hook.signatureHeader)
+		if strings.HasPrefix(signature, "sha1=") {
+			return hook.signatureHeader, signature, "sha1", sha1.New
 		}
-		webhooks.DefaultLog.Debug(fmt.Sprintf("X-Hub-Signature:%s", signature))
+		return hook.signatureHeader, signature, "sha256", sha256.New
+	}
 
-		mac := hmac.New(sha1.New, []byte(hook.secret))
-		mac.Write(payload)
+	// prefer the SHA-256 signature, only falling back to the legacy SHA-1 one when absent
+	header, algorithm, hashFn = "X-Hub-Signature-256", "sha256", sha256.New
+	signature = r.Header.Get(header)
+	if len(signature) == 0 {
+		header, algorithm, hashFn = "X-Hub-Signature", "sha1", sha1.New
+		signature = r.Header.Get(header)
+	}
+	return header, signature, algorithm, hashFn
+}
 
+func (hook Webhook) missingSignatureHeader() string {
+	if len(hook.signatureHeader) > 0 {
+		return hook.signatureHeader
+	}
+	return "X-Hub-Signature"
+}
+
+// signatureCheck computes the HMAC of a payload written to it with every secret,
+// for comparison with the signature of the request
+type signatureCheck struct {
+	header    string
+	signature string
+	algorithm string
+	hashFn    func() hash.Hash
+	macs      []hash.Hash
+	// dummy is set when the only mac is computed with a random secret, which must never match
+	dummy bool
+	// duplicate is set when the request has more than one signature header
+	duplicate bool
+}
+
+// Write adds p to the HMAC computed with every secret
+func (c *signatureCheck) Write(p []byte) (int, error) {
+	for _, mac := range c.macs {
+		mac.Write(p)
+	}
+	return len(p), nil
+}
+
+// newSignatureCheck returns the check the payload of the request must pass,
+// or nil when signatures are not verified
+func (hook Webhook) newSignatureCheck(r *http.Request) *signatureCheck {
+	// If we have a Secret set, or signatures are required, we should check the MAC
+	if len(hook.secrets) == 0 && !hook.requireSig && !hook.uniformSig {
+		return nil
+	}
+
+	c := &signatureCheck{}
+	c.header, c.signature, c.algorithm, c.hashFn = hook.getSignature(r)
+	c.duplicate = len(r.Header.Values(c.header)) > 1
+	for _, secret := range hook.secrets {
+		c.macs = append(c.macs, hmac.New(c.hashFn, secret))
+	}
+
+	// without a secret a random one is used, so rejecting the request takes as long as with one
+	if len(c.macs) == 0 && len(hook.dummySecret) > 0 {
+		c.macs = append(c.macs, hmac.New(c.hashFn, hook.dummySecret))
+		c.dummy = true
+	}
+	return c
+}
+
+// verifySignature checks the signature of the request against the payload read through check,
+// which is nil when signatures are not verified
+func (hook Webhook) verifySignature(w http.ResponseWriter, check *signatureCheck) error {
+	if check == nil {
+		return nil
+	}
+	return hook.checkSignature(w, check)
+}
+
+// compare compares the signature with the HMAC of the payload written to c, returning the index
+// of the secret that matched, or -1, and whether the signature is missing or malformed
+func (c *signatureCheck) compare() (matched int, missing, malformed bool) {
+	missing = len(c.signature) == 0
+
+	// signature is expected in the form <algorithm>=<hex digest>
+	parts := strings.SplitN(c.signature, "=", 2)
+	malformed = !missing && (len(parts) != 2 || parts[0] != c.algorithm)
+
+	// a missing or malformed signature is still compared, against a dummy digest,
+	// so rejecting it takes as long as rejecting a wrong one
+	digest := strings.Repeat("0", c.hashFn().Size()*2)
+	if !missing && !malformed {
+		digest = parts[1]
+	}
+
+	// every secret is compared so the time taken doesn't depend on which one matched
+	matched = -1
+	for i, mac := range c.macs {
 		expectedMAC := hex.EncodeToString(mac.Sum(nil))
 
-		if !hmac.Equal([]byte(signature[5:]), []byte(expectedMAC)) {
-			err := errors.New("HMAC verification failed")
-			webhooks.DefaultLog.Error(err.Error())
-			http.Error(w, err.Error(), http.StatusForbidden)
-			return err
+		if hmac.Equal([]byte(digest), []byte(expectedMAC)) && matched < 0 {
+			matched = i
 		}
 	}
+	if c.dummy {
+		matched = -1
+	}
+	return matched, missing, malformed
+}
+
+// checkSignature compares the signature of the request with the HMAC of the payload written to check
+func (hook Webhook) checkSignature(w http.ResponseWriter, check *signatureCheck) error {
+	hook.logRequest("Checking secret")
+
+	header := check.header
+	if len(check.signature) > 0 {
+		hook.log().Debug(fmt.Sprintf("%s:%s", header, check.signature))
+	}
+
+	matched, missing, malformed := check.compare()
+
+	switch {
+	case check.duplicate:
+		err := newError(ErrDuplicateHeader, "Multiple %s Headers", header)
+		hook.log().Error(err.Error())
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return err
+	case missing:
+		err := newError(ErrMissingSignature, "Missing %s required for HMAC verification", hook.missingSignatureHeader())
+		hook.log().Error(err.Error())
+		http.Error(w, err.Error(), hook.unauthorized)
+		return err
+	case malformed:
+		err := newError(ErrSignatureMismatch, "Malformed %s", header)
+		hook.log().Error(err.Error())
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return err
+	case matched < 0:
+		err := newError(ErrSignatureMismatch, "HMAC verification failed")
+		hook.log().Error(err.Error())
+		http.Error(w, err.Error(), hook.unauthorized)
+		return err
+	}
+	hook.log().Debug(fmt.Sprintf("HMAC verified using secret %d", matched))
 	return nil
 }
 
-func (hook Webhook) readPayload(w http.ResponseWriter, r *http.Request) ([]byte, error) {
-	payload, err := ioutil.ReadAll(r.Body)
-	if err != nil || len(payload) == 0 {
-		err := errors.New("Issue reading Payload")
-		webhooks.DefaultLog.Error(err.Error())
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+// VerifySignature verifies the value of the X-Hub-Signature-256 or X-Hub-Signature header of a delivery
+// against the HMAC of its payload computed with secret, without any HTTP handling, for use with
+// non-HTTP transports such as queue consumers. The error returned can be compared to
+// ErrMissingSignature and ErrSignatureMismatch using errors.Is.
+func VerifySignature(payload []byte, signatureHeader, secret string) error {
+	check := &signatureCheck{signature: signatureHeader, algorithm: "sha256", hashFn: sha256.New}
+	if strings.HasPrefix(signatureHeader, "sha1=") {
+		check.algorithm, check.hashFn = "sha1", sha1.New
+	}
+	check.macs = []hash.Hash{hmac.New(check.hashFn, []byte(secret))}
+	check.Write(payload)
+
+	matched, missing, malformed := check.compare()
+	switch {
+	case missing:
+		return newError(ErrMissingSignature, "Missing signature required for HMAC verification")
+	case malformed:
+		return newError(ErrSignatureMismatch, "Malformed signature")
+	case matched < 0:
+		return newError(ErrSignatureMismatch, "HMAC verification failed")
+	}
+	return nil
+}
+
+// ComputeSignature returns the X-Hub-Signature-256 header value GitHub would send with payload
+// when signing it using secret, for re-emitting signed payloads or testing.
+func ComputeSignature(payload []byte, secret string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// requestBody returns the body of the request, decompressed if a proxy compressed it,
+// as GitHub signs the payload before any compression
+func (hook Webhook) requestBody(w http.ResponseWriter, r *http.Request) (io.Reader, error) {
+	if hook.readTimeout > 0 {
+		// not every http.ResponseWriter supports deadlines, in which case the read is unbounded
+		err := http.NewResponseController(w).SetReadDeadline(time.Now().Add(hook.readTimeout))
+		if err != nil {
+			hook.log().Debug(fmt.Sprintf("Issue setting read deadline: %s", err))
+		}
+	}
+
+	if !strings.EqualFold(r.Header.Get("Content-Encoding"), "gzip") {
+		return r.Body, nil
+	}
+
+	zr, err := gzip.NewReader(r.Body)
+	if err != nil {
+		err = fmt.Errorf("Issue decompressing Payload: %s", err)
+		hook.log().Error(err.Error())
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return nil, err
+	}
+	return zr, nil
+}
+
+// readPayload reads the payload of the request, writing it to check as it is read so the HMAC
+// is computed in the same pass rather than over the payload once it is buffered
+func (hook Webhook) readPayload(w http.ResponseWriter, r *http.Request, check *signatureCheck) ([]byte, error) {
+	body, err := hook.requestBody(w, r)
+	if err != nil {
+		return nil, err
+	}
+	if check != nil {
+		body = io.TeeReader(body, check)
+	}
+
+	// read one byte past the limit to know whether it was exceeded,
+	// the limit applies to the decompressed payload
+	buf := bytes.NewBuffer(make([]byte, 0, hook.sizeHint(r)))
+	_, err = buf.ReadFrom(io.LimitReader(body, hook.maxBody+1))
+	payload := buf.Bytes()
+	if err := hook.checkRead(w, err, int64(len(payload))); err != nil {
+		return nil, err
+	}
+	if err := hook.drainBody(w, r, int64(len(payload))); err != nil {
 		return nil, err
 	}
-	webhooks.DefaultLog.Debug(fmt.Sprintf("Payload:%s", string(payload)))
+	// redacting the payload takes decoding it, which is skipped when debug messages are dropped anyway
+	if webhooks.DebugEnabled(hook.log()) {
+		hook.log().Debug(fmt.Sprintf("Payload:%s", hook.redactPayload(payload)))
+	}
 	return payload, nil
 }
 
-func getGitHubHandler(event Event) (webhooks.ProcessPayloadFunc, error) {
-	fn, ok := hook.eventFuncs[event]
+// drainBody reads the rest of the request body to EOF once the n bytes of its payload were read, which
+// ends before the body does when it was compressed, so any trailers are read before the payload is
+// trusted and the connection can be reused
+func (hook Webhook) drainBody(w http.ResponseWriter, r *http.Request, n int64) error {
+	_, err := io.Copy(ioutil.Discard, io.LimitReader(r.Body, hook.maxBody))
+	return hook.checkRead(w, err, n)
+}
+
+// sizeHint returns the capacity to allocate up front for the payload of the request, so a payload
+// of the length it declares is read without growing the buffer, which reads at least bytes.MinRead
+// bytes at a time. The length is declared before any of the payload is verified, so no more than
+// maxSizeHint is allocated for it, larger payloads growing the buffer as they are read.
+func (hook Webhook) sizeHint(r *http.Request) int64 {
+	if r.ContentLength <= 0 || r.ContentLength > hook.maxBody {
+		return bytes.MinRead
+	}
+	if r.ContentLength+bytes.MinRead > maxSizeHint {
+		return maxSizeHint
+	}
+	return r.ContentLength + bytes.MinRead
+}
+
+// loggedPayload returns the payload as logged, truncated so large payloads don't flood the log
+func loggedPayload(payload []byte) string {
+	if len(payload) <= maxLoggedPayload {
+		return string(payload)
+	}
+	return fmt.Sprintf("%s... (%d bytes)", payload[:maxLoggedPayload], len(payload))
+}
+
+// checkRead responds with the appropriate error, and returns it, when reading the n bytes of a payload failed
+func (hook Webhook) checkRead(w http.ResponseWriter, readErr error, n int64) error {
+	switch {
+	case isTimeout(readErr):
+		err := fmt.Errorf("Timed out reading Payload after %s", hook.readTimeout)
+		hook.log().Error(err.Error())
+		http.Error(w, err.Error(), http.StatusRequestTimeout)
+		return err
+	case readErr != nil:
+		err := errors.New("Issue reading Payload")
+		hook.log().Error(fmt.Sprintf("%s: %s", err, readErr))
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return err
+	case n == 0:
+		err := newError(ErrEmptyPayload, "Empty Payload")
+		hook.log().Error(err.Error())
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return err
+	case n > hook.maxBody:
+		err := fmt.Errorf("Payload exceeds the maximum size of %d bytes", hook.maxBody)
+		hook.log().Error(err.Error())
+		http.Error(w, err.Error(), http.StatusRequestEntityTooLarge)
+		return err
+	}
+	return nil
+}
+
+// isTimeout reports whether err is the result of a read deadline passing
+func isTimeout(err error) bool {
+	var netErr net.Error
+	return errors.Is(err, os.ErrDeadlineExceeded) || (errors.As(err, &netErr) && netErr.Timeout())
+}
+
+func (hook Webhook) getGitHubHandlers(event Event) ([]handler, error) {
+	hook.mu.RLock()
+	fns, ok := hook.eventFuncs[event]
+	hook.mu.RUnlock()
+
 	// if no event registered
 	if !ok {
-		return nil, fmt.Errorf("Webhook Event %s not registered, it is recommended to setup only events in github that will be registered in the webhook to avoid unnecessary traffic and reduce potential attack vectors.", string(event))
+		return nil, newError(ErrUnregisteredEvent, "Webhook Event %s not registered, it is recommended to setup only events in github that will be registered in the webhook to avoid unnecessary traffic and reduce potential attack vectors.", string(event))
+	}
+	return fns, nil
+}
+
+// pong acknowledges the ping GitHub sends when a hook is created, as long as it looks like one,
+// returning an error if it doesn't
+func (hook Webhook) pong(w http.ResponseWriter, payload []byte) error {
+	var ping PingPayload
+	if err := json.Unmarshal(payload, &ping); err != nil || len(ping.Zen) == 0 || ping.HookID == 0 {
+		err := errors.New("Invalid ping Payload")
+		hook.log().Error(err.Error())
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return err
+	}
+	hook.log().Info(fmt.Sprintf("Ping received for hook %d: %s", ping.HookID, ping.Zen))
+	return nil
+}
+
+// decoders maps every known GitHub hook event to the function decoding its payload
+var decoders = map[Event]func([]byte) (interface{}, error){
+	BranchProtectionRuleEvent:        decode[BranchProtectionRulePayload],
+	CheckRunEvent:                    decode[CheckRunPayload],
+	CheckSuiteEvent:                  decode[CheckSuitePayload],
+	CommitCommentEvent:               decode[CommitCommentPayload],
+	CreateEvent:                      decode[CreatePayload],
+	DeleteEvent:                      decode[DeletePayload],
+	DeploymentEvent:                  decode[DeploymentPayload],
+	DeploymentProtectionRuleEvent:    decode[DeploymentProtectionRulePayload],
+	DeploymentReviewEvent:            decode[DeploymentReviewPayload],
+	DeploymentStatusEvent:            decode[DeploymentStatusPayload],
+	DiscussionEvent:                  decode[DiscussionPayload],
+	DiscussionCommentEvent:           decode[DiscussionCommentPayload],
+	ForkEvent:                        decode[ForkPayload],
+	GithubAppAuthorizationEvent:      decode[GithubAppAuthorizationPayload],
+	GollumEvent:                      decode[GollumPayload],
+	InstallationEvent:                decode[InstallationPayload],
+	InstallationRepositoriesEvent:    decode[InstallationRepositoriesPayload],
+	IntegrationInstallationEvent:     decode[InstallationPayload],
+	IssueCommentEvent:                decode[IssueCommentPayload],
+	IssuesEvent:                      decode[IssuesPayload],
+	LabelEvent:                       decode[LabelPayload],
+	MemberEvent:                      decode[MemberPayload],
+	MembershipEvent:                  decode[MembershipPayload],
+	MergeGroupEvent:                  decode[MergeGroupPayload],
+	MilestoneEvent:                   decode[MilestonePayload],
+	OrganizationEvent:                decode[OrganizationPayload],
+	OrgBlockEvent:                    decode[OrgBlockPayload],
+	PackageEvent:                     decode[PackagePayload],
+	PageBuildEvent:                   decode[PageBuildPayload],
+	PingEvent:                        decode[PingPayload],
+	ProjectCardEvent:                 decode[ProjectCardPayload],
+	ProjectColumnEvent:               decode[ProjectColumnPayload],
+	ProjectEvent:                     decode[ProjectPayload],
+	PublicEvent:                      decode[PublicPayload],
+	PullRequestEvent:                 decode[PullRequestPayload],
+	PullRequestReviewEvent:           decode[PullRequestReviewPayload],
+	PullRequestReviewCommentEvent:    decode[PullRequestReviewCommentPayload],
+	PullRequestReviewThreadEvent:     decode[PullRequestReviewThreadPayload],
+	PushEvent:                        decode[PushPayload],
+	ReleaseEvent:                     decode[ReleasePayload],
+	RepositoryEvent:                  decode[RepositoryPayload],
+	RepositoryDispatchEvent:          decode[RepositoryDispatchPayload],
+	SecretScanningAlertEvent:         decode[SecretScanningAlertPayload],
+	SecretScanningAlertLocationEvent: decode[SecretScanningAlertLocationPayload],
+	SponsorshipEvent:                 decode[SponsorshipPayload],
+	StarEvent:                        decode[StarPayload],
+	StatusEvent:                      decode[StatusPayload],
+	TeamEvent:                        decode[TeamPayload],
+	TeamAddEvent:                     decode[TeamAddPayload],
+	WatchEvent:                       decode[WatchPayload],
+	WorkflowJobEvent:                 decode[WorkflowJobPayload],
+	WorkflowRunEvent:                 decode[WorkflowRunPayload],
+}
+
+// payloadTypes maps every known GitHub hook event to the type its decoder decodes its payload into,
+// which is the type of the payload an empty object decodes to
+var payloadTypes = func() map[Event]reflect.Type {
+	types := make(map[Event]reflect.Type, len(decoders))
+	for event, fn := range decoders {
+		pl, _ := fn([]byte("{}"))
+		types[event] = reflect.TypeOf(pl)
+	}
+	return types
+}()
+
+// payloadType returns the type ParsePayloadBytes decodes the payload of the event into,
+// or nil if the event is unknown
+func payloadType(event Event) reflect.Type {
+	return payloadTypes[event]
+}
+
+// decode unmarshals a payload into T, it can be used as one of the decoders
+func decode[T any](payload []byte) (interface{}, error) {
+	var pl T
+	err := json.Unmarshal(payload, &pl)
+	return pl, err
+}
+
+// ParsePayloadBytes decodes the payload of the given event into its concrete payload type
+// without any HTTP handling, for use with non-HTTP transports such as queue consumers.
+func ParsePayloadBytes(event Event, payload []byte) (interface{}, error) {
+	fn, ok := decoders[event]
+	if !ok {
+		return nil, newError(ErrUnknownEvent, "Unknown Webhook Event %s", string(event))
 	}
-	return fn, nil
+	return fn(payload)
+}
+
+// ParseBatch decodes each payload as the payload of the event at the same index using ParsePayloadBytes,
+// for transports that bundle several deliveries together. A payload failing to decode doesn't stop
+// the others from being decoded, its error is returned at its index instead, as is an error for an
+// event or payload missing its counterpart.
+func ParseBatch(events []Event, payloads [][]byte) ([]interface{}, []error) {
+	n := len(events)
+	if len(payloads) > n {
+		n = len(payloads)
+	}
+
+	results := make([]interface{}, n)
+	errs := make([]error, n)
+	for i := 0; i < n; i++ {
+		switch {
+		case i >= len(events):
+			errs[i] = newError(ErrMissingEvent, "Missing event for payload %d", i)
+		case i >= len(payloads):
+			errs[i] = newError(ErrEmptyPayload, "Missing payload for event %d", i)
+		default:
+			results[i], errs[i] = ParsePayloadBytes(events[i], payloads[i])
+		}
+	}
+	return results, errs
+}
+
+// decodePayload decodes the payload of the given event into the payload type this Webhook instance uses for it
+func (hook Webhook) decodePayload(event Event, payload []byte) (interface{}, error) {
+	if target, ok := hook.target(event); ok {
+		v := target()
+		err := hook.unmarshal(payload, v)
+		return v, err
+	}
+	if hook.strict {
+		return hook.decodeStrict(event, payload)
+	}
+	if hook.lazy && event == PushEvent {
+		return decode[LazyPushPayload](payload)
+	}
+	return ParsePayloadBytes(event, payload)
+}
+
+// payloadTypeOf returns the type this Webhook instance decodes the payload of the event into,
+// unless a type is registered for it with RegisterInto
+func (hook Webhook) payloadTypeOf(event Event) (reflect.Type, error) {
+	if hook.lazy && event == PushEvent {
+		return reflect.TypeOf(LazyPushPayload{}), nil
+	}
+
+	t := payloadType(event)
+	if t == nil {
+		return nil, newError(ErrUnknownEvent, "Unknown Webhook Event %s", string(event))
+	}
+	return t, nil
 }
 
 // ParsePayload parses and verifies the payload and fires off the mapped function, if it exists.
 func (hook Webhook) ParsePayload(w http.ResponseWriter, r *http.Request) {
-	gitHubEvent, err := getGitHubEvent(w, r)
-	if err != nil {
-		webhooks.DefaultLog.Error(err.Error())
+	// hook is a copy, so the fields are only attached to the logging of this delivery
+	hook.logger = webhooks.WithFields(hook.log(),
+		"event", r.Header.Get(hook.eventHeader),
+		"delivery_id", r.Header.Get("X-GitHub-Delivery"),
+	)
+
+	if hook.observer == nil {
+		hook.parsePayload(w, r)
 		return
 	}
 
-	fn, err := getGitHubHandler(gitHubEvent)
+	start := time.Now()
+	sw := &statusWriter{ResponseWriter: w, status: http.StatusOK}
+	hook.timings = &deliveryTimings{}
+	outcome, err := hook.parsePayload(sw, r)
+
+	hook.observer(Observation{
+		Event:           Event(r.Header.Get(hook.eventHeader)),
+		Status:          sw.status,
+		Duration:        time.Since(start),
+		Outcome:         outcome,
+		Err:             err,
+		DecodeDuration:  hook.timings.decode,
+		HandlerDuration: hook.timings.handler,
+	})
+}
+
+// ServeHTTP makes a Webhook an http.Handler, so it can be mounted directly on any mux.
+func (hook Webhook) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	hook.ParsePayload(w, r)
+}
+
+// Handler returns the Webhook as an http.Handler wrapped in the given middlewares, the first
+// of which is the outermost, so requests pass through them in the order given.
+func (hook Webhook) Handler(middlewares ...func(http.Handler) http.Handler) http.Handler {
+	var h http.Handler = hook
+	for i := len(middlewares) - 1; i >= 0; i-- {
+		h = middlewares[i](h)
+	}
+	return h
+}
+
+// Parse verifies and decodes the payload of the request without writing a response or calling
+// the registered functions, leaving both to the caller. The payload of an event unknown to this
+// package is returned as a json.RawMessage when a catch-all is set.
+func (hook Webhook) Parse(r *http.Request) (Event, interface{}, error) {
+	w := newDiscardWriter()
+
+	if err := hook.checkMethod(w, r); err != nil {
+		return "", nil, err
+	}
+
+	if err := hook.checkUserAgent(w, r); err != nil {
+		return "", nil, err
+	}
+
+	event, err := hook.getGitHubEvent(w, r)
 	if err != nil {
-		webhooks.DefaultLog.Error(err.Error())
-		return
+		return "", nil, err
 	}
 
-	if err := verifySignature(w, r); err != nil {
-		Webhook.DefaultLog.Debug(err.Error())
-		return
+	check := hook.newSignatureCheck(r)
+	payload, err := hook.readPayload(w, r, check)
+	if err != nil {
+		return event, nil, err
+	}
+
+	if err := hook.verifySignature(w, check); err != nil {
+		return event, nil, err
 	}
 
-	payload, err := readPayload(w, r)
+	payload, err = hook.formPayload(w, r, payload)
 	if err != nil {
-		Webhook.DefaultLog.Debug(err.Error())
-		return
+		return event, nil, err
+	}
+
+	if !event.IsValid() {
+		return event, json.RawMessage(payload), nil
+	}
+
+	pl, err := hook.decodePayload(event, payload)
+	if err != nil {
+		return event, nil, fmt.Errorf("Issue decoding %s Payload: %w", string(event), err)
+	}
+
+	if err := hook.checkPayload(w, event, pl); err != nil {
+		return event, nil, err
+	}
+
+	if err := hook.checkAge(w, event, pl); err != nil {
+		return event, nil, err
+	}
+	return event, pl, nil
+}
+
+// ParseReader reads, verifies and decodes the payload of the event from body without any net/http types,
+// for servers such as fasthttp and for tests. header holds the headers the payload was delivered with,
+// its X-Hub-Signature-256 or X-Hub-Signature is verified using secret unless it's empty. The payload is
+// otherwise handled as Parse handles a request, including the size limit and gzip Content-Encoding.
+func ParseReader(event Event, body io.Reader, header webhooks.Header, secret string) (interface{}, error) {
+	hook, err := New(WithSecret(secret))
+	if err != nil {
+		return nil, err
+	}
+
+	h := http.Header(header).Clone()
+	if h == nil {
+		h = http.Header{}
+	}
+	h.Set(hook.eventHeader, string(event))
+
+	_, pl, err := hook.Parse(&http.Request{Method: http.MethodPost, Header: h, Body: ioutil.NopCloser(body), ContentLength: -1})
+	return pl, err
+}
+
+// discardWriter is an http.ResponseWriter that discards the response, for handling a delivery
+// outside of an HTTP request, as the helpers respond as they go
+type discardWriter struct {
+	header http.Header
+}
+
+func newDiscardWriter() discardWriter {
+	return discardWriter{header: http.Header{}}
+}
+
+func (w discardWriter) Header() http.Header         { return w.header }
+func (w discardWriter) Write(b []byte) (int, error) { return len(b), nil }
+func (w discardWriter) WriteHeader(int)             {}
+
+// parsePayload handles the delivery, returning how it was handled along with the error
+// that kept it from being processed, if any
+func (hook Webhook) parsePayload(w http.ResponseWriter, r *http.Request) (Outcome, error) {
+	if err := hook.checkMethod(w, r); err != nil {
+		hook.log().Error(err.Error())
+		return OutcomeInvalidMethod, err
+	}
+
+	if err := hook.checkUserAgent(w, r); err != nil {
+		hook.log().Info(err.Error())
+		return OutcomeBadUserAgent, err
 	}
 
-	// Make headers available to ProcessPayloadFunc as a webhooks type
-	hd := webhooks.Header(r.Header)
-
-	switch gitHubEvent {
-	case CommitCommentEvent:
-		var cc CommitCommentPayload
-		json.Unmarshal([]byte(payload), &cc)
-		hook.runProcessPayloadFunc(fn, cc, hd)
-	case CreateEvent:
-		var c CreatePayload
-		json.Unmarshal([]byte(payload), &c)
-		hook.runProcessPayloadFunc(fn, c, hd)
-	case DeleteEvent:
-		var d DeletePayload
-		json.Unmarshal([]byte(payload), &d)
-		hook.runProcessPayloadFunc(fn, d, hd)
-	case DeploymentEvent:
-		var d DeploymentPayload
-		json.Unmarshal([]byte(payload), &d)
-		hook.runProcessPayloadFunc(fn, d, hd)
-	case DeploymentStatusEvent:
-		var d DeploymentStatusPayload
-		json.Unmarshal([]byte(payload), &d)
-		hook.runProcessPayloadFunc(fn, d, hd)
-	case ForkEvent:
-		var f ForkPayload
-		json.Unmarshal([]byte(payload), &f)
-		hook.runProcessPayloadFunc(fn, f, hd)
-	case GollumEvent:
-		var g GollumPayload
-		json.Unmarshal([]byte(payload), &g)
-		hook.runProcessPayloadFunc(fn, g, hd)
-	case InstallationEvent, IntegrationInstallationEvent:
-		var i InstallationPayload
-		json.Unmarshal([]byte(payload), &i)
-		hook.runProcessPayloadFunc(fn, i, hd)
-	case IssueCommentEvent:
-		var i IssueCommentPayload
-		json.Unmarshal([]byte(payload), &i)
-		hook.runProcessPayloadFunc(fn, i, hd)
-	case IssuesEvent:
-		var i IssuesPayload
-		json.Unmarshal([]byte(payload), &i)
-		hook.runProcessPayloadFunc(fn, i, hd)
-	case LabelEvent:
-		var l LabelPayload
-		json.Unmarshal([]byte(payload), &l)
-		hook.runProcessPayloadFunc(fn, l, hd)
-	case MemberEvent:
-		var m MemberPayload
-		json.Unmarshal([]byte(payload), &m)
-		hook.runProcessPayloadFunc(fn, m, hd)
-	case MembershipEvent:
-		var m MembershipPayload
-		json.Unmarshal([]byte(payload), &m)
-		hook.runProcessPayloadFunc(fn, m, hd)
-	case MilestoneEvent:
-		var m MilestonePayload
-		json.Unmarshal([]byte(payload), &m)
-		hook.runProcessPayloadFunc(fn, m, hd)
-	case OrganizationEvent:
-		var o OrganizationPayload
-		json.Unmarshal([]byte(payload), &o)
-		hook.runProcessPayloadFunc(fn, o, hd)
-	case OrgBlockEvent:
-		var o OrgBlockPayload
-		json.Unmarshal([]byte(payload), &o)
-		hook.runProcessPayloadFunc(fn, o, hd)
-	case PageBuildEvent:
-		var p PageBuildPayload
-		json.Unmarshal([]byte(payload), &p)
-		hook.runProcessPayloadFunc(fn, p, hd)
-	case PingEvent:
-		var p PingPayload
-		json.Unmarshal([]byte(payload), &p)
-		hook.runProcessPayloadFunc(fn, p, hd)
-	case ProjectCardEvent:
-		var p ProjectCardPayload
-		json.Unmarshal([]byte(payload), &p)
-		hook.runProcessPayloadFunc(fn, p, hd)
-	case ProjectColumnEvent:
-		var p ProjectColumnPayload
-		json.Unmarshal([]byte(payload), &p)
-		hook.runProcessPayloadFunc(fn, p, hd)
-	case ProjectEvent:
-		var p ProjectPayload
-		json.Unmarshal([]byte(payload), &p)
-		hook.runProcessPayloadFunc(fn, p, hd)
-	case PublicEvent:
-		var p PublicPayload
-		json.Unmarshal([]byte(payload), &p)
-		hook.runProcessPayloadFunc(fn, p, hd)
-	case PullRequestEvent:
-		var p PullRequestPayload
-		json.Unmarshal([]byte(payload), &p)
-		hook.runProcessPayloadFunc(fn, p, hd)
-	case PullRequestReviewEvent:
-		var p PullRequestReviewPayload
-		json.Unmarshal([]byte(payload), &p)
-		hook.runProcessPayloadFunc(fn, p, hd)
-	case PullRequestReviewCommentEvent:
-		var p PullRequestReviewCommentPayload
-		json.Unmarshal([]byte(payload), &p)
-		hook.runProcessPayloadFunc(fn, p, hd)
-	case PushEvent:
-		var p PushPayload
-		json.Unmarshal([]byte(payload), &p)
-		hook.runProcessPayloadFunc(fn, p, hd)
-	case ReleaseEvent:
-		var r ReleasePayload
-		json.Unmarshal([]byte(payload), &r)
-		hook.runProcessPayloadFunc(fn, r, hd)
-	case RepositoryEvent:
-		var r RepositoryPayload
-		json.Unmarshal([]byte(payload), &r)
-		hook.runProcessPayloadFunc(fn, r, hd)
-	case StatusEvent:
-		var s StatusPayload
-		json.Unmarshal([]byte(payload), &s)
-		hook.runProcessPayloadFunc(fn, s, hd)
-	case TeamEvent:
-		var t TeamPayload
-		json.Unmarshal([]byte(payload), &t)
-		hook.runProcessPayloadFunc(fn, t, hd)
-	case TeamAddEvent:
-		var t TeamAddPayload
-		json.Unmarshal([]byte(payload), &t)
-		hook.runProcessPayloadFunc(fn, t, hd)
-	case WatchEvent:
-		var w WatchPayload
-		json.Unmarshal([]byte(payload), &w)
-		hook.runProcessPayloadFunc(fn, w, hd)
-	}
-}
-
-func (hook Webhook) runProcessPayloadFunc(
-	fn webhooks.ProcessPayloadFunc,
+	gitHubEvent, err := hook.getGitHubEvent(w, r)
+	if err != nil {
+		hook.log().Error(err.Error())
+		return OutcomeInvalidEvent, err
+	}
+
+	// only the JSON payloads of registered events are streamed, the others are needed raw
+	if hook.stream && !isForm(r) {
+		if handlers, err := hook.getGitHubHandlers(gitHubEvent); err == nil {
+			return hook.streamPayload(w, r, gitHubEvent, handlers)
+		}
+	}
+
+	// the signature is computed over the raw body as it is read
+	check := hook.newSignatureCheck(r)
+	payload, err := hook.readPayload(w, r, check)
+	if err != nil {
+		hook.log().Debug(err.Error())
+		return OutcomeReadError, err
+	}
+
+	if err := hook.verifySignature(w, check); err != nil {
+		hook.log().Debug(err.Error())
+		return OutcomeSignatureError, err
+	}
+
+	payload, err = hook.formPayload(w, r, payload)
+	if err != nil {
+		return OutcomeDecodeError, err
+	}
+
+	meta := hook.getDeliveryMeta(gitHubEvent, r)
+	meta.raw = payload
+
+	if hook.isDuplicate(meta) {
+		return OutcomeDuplicate, nil
+	}
+
+	outcome, err := hook.dispatch(w, gitHubEvent, payload, meta)
+	if err != nil {
+		hook.forgetDelivery(meta)
+	}
+	return outcome, err
+}
+
+// dispatch decodes the verified payload of the event and calls the functions registered for it
+func (hook Webhook) dispatch(w http.ResponseWriter, gitHubEvent Event, payload []byte, meta DeliveryMeta) (Outcome, error) {
+	// unregistered events are still acknowledged so GitHub doesn't keep retrying them
+	handlers, err := hook.getGitHubHandlers(gitHubEvent)
+	if err != nil {
+		if gitHubEvent == PingEvent && hook.autoPong {
+			if err := hook.pong(w, payload); err != nil {
+				return OutcomeDecodeError, err
+			}
+			return OutcomeProcessed, nil
+		}
+		if catchAll := hook.getCatchAll(); catchAll != nil {
+			return hook.runProcessPayloadFuncs(w, []ProcessDeliveryFunc{runCatchAll(catchAll)}, json.RawMessage(payload), meta)
+		}
+		hook.log().Info(err.Error())
+		return OutcomeUnregistered, err
+	}
+
+	// the action is peeked at so payloads no function wants aren't decoded,
+	// a payload whose action can't be peeked at is left to fail decoding
+	var action string
+	if isFiltered(handlers) {
+		action, err = peekAction(payload)
+		if err == nil && len(matchHandlers(handlers, action)) == 0 {
+			hook.logRequest(fmt.Sprintf("Webhook Event %s action %s not registered", string(gitHubEvent), action))
+			return OutcomeFiltered, nil
+		}
+	}
+
+	decodeStart := time.Now()
+	pl, err := hook.decodePayload(gitHubEvent, payload)
+	hook.recordDecode(decodeStart)
+	if err != nil {
+		err = fmt.Errorf("Issue decoding %s Payload: %w", string(gitHubEvent), err)
+		hook.log().Error(err.Error())
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return OutcomeDecodeError, err
+	}
+
+	if err := hook.checkPayload(w, gitHubEvent, pl); err != nil {
+		hook.log().Info(err.Error())
+		return OutcomeInvalidPayload, err
+	}
+
+	if err := hook.checkAge(w, gitHubEvent, pl); err != nil {
+		hook.log().Info(err.Error())
+		return OutcomeTooOld, err
+	}
+
+	if err := hook.checkRate(w, gitHubEvent, pl); err != nil {
+		hook.log().Info(err.Error())
+		return OutcomeRateLimited, err
+	}
+
+	return hook.runProcessPayloadFuncs(w, matchHandlers(handlers, action), pl, meta)
+}
+
+func (hook Webhook) runProcessPayloadFuncs(
+	w http.ResponseWriter,
+	fns []ProcessDeliveryFunc,
 	results interface{},
-	header webhooks.Header,
-) {
-	fn(results, header)
+	meta DeliveryMeta,
+) (Outcome, error) {
+	if p, ok := results.(interface{ Subtype() EventSubtype }); ok {
+		meta.Subtype = p.Subtype()
+	}
+	if p, ok := results.(RepoScoped); ok {
+		hook.logger = webhooks.WithFields(hook.log(), "repository", p.RepoFullName(), "sender", p.SenderLogin())
+	}
+	defer hook.recordHandler(time.Now())
+
+	if hook.pool == nil {
+		if err := callProcessPayloadFuncs(hook.log(), hook.onPanic, fns, results, meta); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return OutcomeHandlerError, err
+		}
+		return OutcomeProcessed, nil
+	}
+
+	if err := hook.pool.enqueue(job{log: hook.log(), onPanic: hook.onPanic, fns: fns, payload: results, meta: meta}); err != nil {
+		hook.log().Error(err.Error())
+		http.Error(w, err.Error(), http.StatusServiceUnavailable)
+		return OutcomeQueueError, err
+	}
+	return OutcomeProcessed, nil
+}
+
+// runCatchAll returns a ProcessDeliveryFunc calling the catch-all with the raw payload
+func runCatchAll(catchAll func(Event, json.RawMessage, webhooks.Header)) ProcessDeliveryFunc {
+	return func(payload interface{}, meta DeliveryMeta) error {
+		catchAll(meta.Event, payload.(json.RawMessage), meta.Header)
+		return nil
+	}
+}
+
+// callProcessPayloadFuncs calls every fn in registration order, regardless of the others failing,
+// and returns the first error that means the delivery should be retried
+func callProcessPayloadFuncs(log webhooks.Logger, onPanic func(Event, interface{}), fns []ProcessDeliveryFunc, results interface{}, meta DeliveryMeta) error {
+	var retryErr error
+	for _, fn := range fns {
+		if err := callProcessPayloadFunc(log, onPanic, fn, results, meta); err != nil && retryErr == nil {
+			retryErr = err
+		}
+	}
+	return retryErr
+}
+
+// callProcessPayloadFunc calls fn and returns its error if the delivery should be retried,
+// which a panic in fn is recovered into
+func callProcessPayloadFunc(log webhooks.Logger, onPanic func(Event, interface{}), fn ProcessDeliveryFunc, results interface{}, meta DeliveryMeta) (err error) {
+	defer func() {
+		r := recover()
+		if r == nil {
+			return
+		}
+
+		err = newError(ErrHandlerPanic, "Webhook Event %s delivery %s panicked: %v", string(meta.Event), meta.DeliveryID, r)
+		log.Error(fmt.Sprintf("%s\n%s", err, debug.Stack()))
+		if onPanic != nil {
+			onPanic(meta.Event, r)
+		}
+	}()
+
+	err = fn(results, meta)
+	if err == nil {
+		return nil
+	}
+
+	if errors.Is(err, webhooks.ErrDropDelivery) {
+		log.Error(fmt.Sprintf("Webhook Event %s delivery %s dropped: %s", string(meta.Event), meta.DeliveryID, err))
+		return nil
+	}
+
+	err = fmt.Errorf("Webhook Event %s delivery %s failed: %s", string(meta.Event), meta.DeliveryID, err)
+	log.Error(err.Error())
+	return err
 }
@@ -1,42 +1,43 @@
 package github
 
-import "time"
+import (
+	"encoding/json"
+	"time"
+)
 
-// CommitCommentPayload contains the information for GitHub's commit_comment hook event
-type CommitCommentPayload struct {
-	Action  string `json:"action"`
-	Comment struct {
-		URL     string `json:"url"`
-		HTMLURL string `json:"html_url"`
-		ID      int64  `json:"id"`
-		User    struct {
-			Login             string `json:"login"`
-			ID                int64  `json:"id"`
-			AvatarURL         string `json:"avatar_url"`
-			GravatarID        string `json:"gravatar_id"`
-			URL               string `json:"url"`
-			HTMLURL           string `json:"html_url"`
-			FollowersURL      string `json:"followers_url"`
-			FollowingURL      string `json:"following_url"`
-			GistsURL          string `json:"gists_url"`
-			StarredURL        string `json:"starred_url"`
-			SubscriptionsURL  string `json:"subscriptions_url"`
-			OrganizationsURL  string `json:"organizations_url"`
-			ReposURL          string `json:"repos_url"`
-			EventsURL         string `json:"events_url"`
-			ReceivedEventsURL string `json:"received_events_url"`
-			Type              string `json:"type"`
-			SiteAdmin         bool   `json:"site_admin"`
-		} `json:"user"`
-		Position          *int64    `json:"position"`
-		Line              *int64    `json:"line"`
-		Path              *string   `json:"path"`
-		CommitID          string    `json:"commit_id"`
-		CreatedAt         time.Time `json:"created_at"`
-		UpdatedAt         time.Time `json:"updated_at"`
-		Body              string    `json:"body"`
-		AuthorAssociation string    `json:"author_association"`
-	} `json:"comment"`
+// BranchProtectionRulePayload contains the information for GitHub's branch_protection_rule hook event
+type BranchProtectionRulePayload struct {
+	Action string `json:"action"`
+	Rule   struct {
+		ID                                       int64     `json:"id"`
+		RepositoryID                             int64     `json:"repository_id"`
+		Name                                     string    `json:"name"`
+		CreatedAt                                time.Time `json:"created_at"`
+		UpdatedAt                                time.Time `json:"updated_at"`
+		PullRequestReviewsEnforcementLevel       string    `json:"pull_request_reviews_enforcement_level"`
+		RequiredApprovingReviewCount             int64     `json:"required_approving_review_count"`
+		DismissStaleReviewsOnPush                bool      `json:"dismiss_stale_reviews_on_push"`
+		RequireCodeOwnerReview                   bool      `json:"require_code_owner_review"`
+		AuthorizedDismissalActorsOnly            bool      `json:"authorized_dismissal_actors_only"`
+		IgnoreApprovalsFromContributors          bool      `json:"ignore_approvals_from_contributors"`
+		RequiredStatusChecks                     []string  `json:"required_status_checks"`
+		RequiredStatusChecksEnforcementLevel     string    `json:"required_status_checks_enforcement_level"`
+		StrictRequiredStatusChecksPolicy         bool      `json:"strict_required_status_checks_policy"`
+		SignatureRequirementEnforcementLevel     string    `json:"signature_requirement_enforcement_level"`
+		LinearHistoryRequirementEnforcementLevel string    `json:"linear_history_requirement_enforcement_level"`
+		AdminEnforced                            bool      `json:"admin_enforced"`
+		AllowForcePushesEnforcementLevel         string    `json:"allow_force_pushes_enforcement_level"`
+		AllowDeletionsEnforcementLevel           string    `json:"allow_deletions_enforcement_level"`
+		MergeQueueEnforcementLevel               string    `json:"merge_queue_enforcement_level"`
+		RequiredDeploymentsEnforcementLevel      string    `json:"required_deployments_enforcement_level"`
+		RequiredConversationResolutionLevel      string    `json:"required_conversation_resolution_level"`
+		AuthorizedActorsOnly                     bool      `json:"authorized_actors_only"`
+		AuthorizedActorNames                     []string  `json:"authorized_actor_names"`
+	} `json:"rule"`
+	// Changes holds the previous value of each rule setting an edit changed, keyed by setting
+	Changes map[string]struct {
+		From json.RawMessage `json:"from"`
+	} `json:"changes"`
 	Repository struct {
 		ID       int64  `json:"id"`
 		Name     string `json:"name"`
@@ -143,16 +144,103 @@ type CommitCommentPayload struct {
 		Type              string `json:"type"`
 		SiteAdmin         bool   `json:"site_admin"`
 	} `json:"sender"`
+	Installation Installation `json:"installation"`
 }
 
-// CreatePayload contains the information for GitHub's create hook event
-type CreatePayload struct {
-	Ref          string `json:"ref"`
-	RefType      string `json:"ref_type"`
-	MasterBranch string `json:"master_branch"`
-	Description  string `json:"description"`
-	PusherType   string `json:"pusher_type"`
-	Repository   struct {
+// CheckRunPayload contains the information for GitHub's check_run hook event
+type CheckRunPayload struct {
+	Action   string `json:"action"`
+	CheckRun struct {
+		ID          int64      `json:"id"`
+		NodeID      string     `json:"node_id"`
+		Name        string     `json:"name"`
+		HeadSha     string     `json:"head_sha"`
+		ExternalID  string     `json:"external_id"`
+		URL         string     `json:"url"`
+		HTMLURL     string     `json:"html_url"`
+		DetailsURL  string     `json:"details_url"`
+		Status      string     `json:"status"`
+		Conclusion  *string    `json:"conclusion"`
+		StartedAt   time.Time  `json:"started_at"`
+		CompletedAt *time.Time `json:"completed_at"`
+		Output      struct {
+			Title            *string `json:"title"`
+			Summary          *string `json:"summary"`
+			Text             *string `json:"text"`
+			AnnotationsCount int64   `json:"annotations_count"`
+			AnnotationsURL   string  `json:"annotations_url"`
+		} `json:"output"`
+		CheckSuite struct {
+			ID         int64     `json:"id"`
+			NodeID     string    `json:"node_id"`
+			HeadBranch string    `json:"head_branch"`
+			HeadSha    string    `json:"head_sha"`
+			Status     string    `json:"status"`
+			Conclusion *string   `json:"conclusion"`
+			URL        string    `json:"url"`
+			Before     string    `json:"before"`
+			After      string    `json:"after"`
+			CreatedAt  time.Time `json:"created_at"`
+			UpdatedAt  time.Time `json:"updated_at"`
+		} `json:"check_suite"`
+		App struct {
+			ID     int64  `json:"id"`
+			Slug   string `json:"slug"`
+			NodeID string `json:"node_id"`
+			Owner  struct {
+				Login             string `json:"login"`
+				ID                int64  `json:"id"`
+				AvatarURL         string `json:"avatar_url"`
+				GravatarID        string `json:"gravatar_id"`
+				URL               string `json:"url"`
+				HTMLURL           string `json:"html_url"`
+				FollowersURL      string `json:"followers_url"`
+				FollowingURL      string `json:"following_url"`
+				GistsURL          string `json:"gists_url"`
+				StarredURL        string `json:"starred_url"`
+				SubscriptionsURL  string `json:"subscriptions_url"`
+				OrganizationsURL  string `json:"organizations_url"`
+				ReposURL          string `json:"repos_url"`
+				EventsURL         string `json:"events_url"`
+				ReceivedEventsURL string `json:"received_events_url"`
+				Type              string `json:"type"`
+				SiteAdmin         bool   `json:"site_admin"`
+			} `json:"owner"`
+			Name        string    `json:"name"`
+			Description string    `json:"description"`
+			ExternalURL string    `json:"external_url"`
+			HTMLURL     string    `json:"html_url"`
+			CreatedAt   time.Time `json:"created_at"`
+			UpdatedAt   time.Time `json:"updated_at"`
+		} `json:"app"`
+		PullRequests []struct {
+			URL    string `json:"url"`
+			ID     int64  `json:"id"`
+			Number int64  `json:"number"`
+			Head   struct {
+				Ref  string `json:"ref"`
+				Sha  string `json:"sha"`
+				Repo struct {
+					ID   int64  `json:"id"`
+					URL  string `json:"url"`
+					Name string `json:"name"`
+				} `json:"repo"`
+			} `json:"head"`
+			Base struct {
+				Ref  string `json:"ref"`
+				Sha  string `json:"sha"`
+				Repo struct {
+					ID   int64  `json:"id"`
+					URL  string `json:"url"`
+					Name string `json:"name"`
+				} `json:"repo"`
+			} `json:"base"`
+		} `json:"pull_requests"`
+	} `json:"check_run"`
+	RequestedAction struct {
+		Identifier string `json:"identifier"`
+	} `json:"requested_action"`
+	Repository struct {
 		ID       int64  `json:"id"`
 		Name     string `json:"name"`
 		FullName string `json:"full_name"`
@@ -258,13 +346,94 @@ type CreatePayload struct {
 		Type              string `json:"type"`
 		SiteAdmin         bool   `json:"site_admin"`
 	} `json:"sender"`
+	Installation Installation `json:"installation"`
 }
 
-// DeletePayload contains the information for GitHub's delete hook event
-type DeletePayload struct {
-	Ref        string `json:"ref"`
-	RefType    string `json:"ref_type"`
-	PusherType string `json:"pusher_type"`
+// CheckSuitePayload contains the information for GitHub's check_suite hook event
+type CheckSuitePayload struct {
+	Action     string `json:"action"`
+	CheckSuite struct {
+		ID           int64   `json:"id"`
+		NodeID       string  `json:"node_id"`
+		HeadBranch   string  `json:"head_branch"`
+		HeadSha      string  `json:"head_sha"`
+		Status       string  `json:"status"`
+		Conclusion   *string `json:"conclusion"`
+		URL          string  `json:"url"`
+		Before       string  `json:"before"`
+		After        string  `json:"after"`
+		PullRequests []struct {
+			URL    string `json:"url"`
+			ID     int64  `json:"id"`
+			Number int64  `json:"number"`
+			Head   struct {
+				Ref  string `json:"ref"`
+				Sha  string `json:"sha"`
+				Repo struct {
+					ID   int64  `json:"id"`
+					URL  string `json:"url"`
+					Name string `json:"name"`
+				} `json:"repo"`
+			} `json:"head"`
+			Base struct {
+				Ref  string `json:"ref"`
+				Sha  string `json:"sha"`
+				Repo struct {
+					ID   int64  `json:"id"`
+					URL  string `json:"url"`
+					Name string `json:"name"`
+				} `json:"repo"`
+			} `json:"base"`
+		} `json:"pull_requests"`
+		App struct {
+			ID     int64  `json:"id"`
+			Slug   string `json:"slug"`
+			NodeID string `json:"node_id"`
+			Owner  struct {
+				Login             string `json:"login"`
+				ID                int64  `json:"id"`
+				AvatarURL         string `json:"avatar_url"`
+				GravatarID        string `json:"gravatar_id"`
+				URL               string `json:"url"`
+				HTMLURL           string `json:"html_url"`
+				FollowersURL      string `json:"followers_url"`
+				FollowingURL      string `json:"following_url"`
+				GistsURL          string `json:"gists_url"`
+				StarredURL        string `json:"starred_url"`
+				SubscriptionsURL  string `json:"subscriptions_url"`
+				OrganizationsURL  string `json:"organizations_url"`
+				ReposURL          string `json:"repos_url"`
+				EventsURL         string `json:"events_url"`
+				ReceivedEventsURL string `json:"received_events_url"`
+				Type              string `json:"type"`
+				SiteAdmin         bool   `json:"site_admin"`
+			} `json:"owner"`
+			Name        string    `json:"name"`
+			Description string    `json:"description"`
+			ExternalURL string    `json:"external_url"`
+			HTMLURL     string    `json:"html_url"`
+			CreatedAt   time.Time `json:"created_at"`
+			UpdatedAt   time.Time `json:"updated_at"`
+		} `json:"app"`
+		CreatedAt            time.Time `json:"created_at"`
+		UpdatedAt            time.Time `json:"updated_at"`
+		LatestCheckRunsCount int64     `json:"latest_check_runs_count"`
+		CheckRunsURL         string    `json:"check_runs_url"`
+		HeadCommit           struct {
+			ID        string    `json:"id"`
+			TreeID    string    `json:"tree_id"`
+			Message   string    `json:"message"`
+			Timestamp time.Time `json:"timestamp"`
+			Author    struct {
+				Name  string `json:"name"`
+				Email string `json:"email"`
+			} `json:"author"`
+			Committer struct {
+				Name  string `json:"name"`
+				Email string `json:"email"`
+			} `json:"committer"`
+		} `json:"head_commit"`
+	} `json:"check_suite"`
 	Repository struct {
 		ID       int64  `json:"id"`
 		Name     string `json:"name"`
@@ -371,21 +540,17 @@ type DeletePayload struct {
 		Type              string `json:"type"`
 		SiteAdmin         bool   `json:"site_admin"`
 	} `json:"sender"`
+	Installation Installation `json:"installation"`
 }
 
-// DeploymentPayload contains the information for GitHub's deployment hook
-type DeploymentPayload struct {
-	Deployment struct {
+// CommitCommentPayload contains the information for GitHub's commit_comment hook event
+type CommitCommentPayload struct {
+	Action  string `json:"action"`
+	Comment struct {
 		URL     string `json:"url"`
+		HTMLURL string `json:"html_url"`
 		ID      int64  `json:"id"`
-		Sha     string `json:"sha"`
-		Ref     string `json:"ref"`
-		Task    string `json:"task"`
-		Payload struct {
-		} `json:"payload"`
-		Environment string  `json:"environment"`
-		Description *string `json:"description"`
-		Creator     struct {
+		User    struct {
 			Login             string `json:"login"`
 			ID                int64  `json:"id"`
 			AvatarURL         string `json:"avatar_url"`
@@ -403,12 +568,16 @@ type DeploymentPayload struct {
 			ReceivedEventsURL string `json:"received_events_url"`
 			Type              string `json:"type"`
 			SiteAdmin         bool   `json:"site_admin"`
-		} `json:"creator"`
-		CreatedAt     time.Time `json:"created_at"`
-		UpdatedAt     time.Time `json:"updated_at"`
-		StatusesURL   string    `json:"statuses_url"`
-		RepositoryURL string    `json:"repository_url"`
-	} `json:"deployment"`
+		} `json:"user"`
+		Position          *int64    `json:"position"`
+		Line              *int64    `json:"line"`
+		Path              *string   `json:"path"`
+		CommitID          string    `json:"commit_id"`
+		CreatedAt         time.Time `json:"created_at"`
+		UpdatedAt         time.Time `json:"updated_at"`
+		Body              string    `json:"body"`
+		AuthorAssociation string    `json:"author_association"`
+	} `json:"comment"`
 	Repository struct {
 		ID       int64  `json:"id"`
 		Name     string `json:"name"`
@@ -515,75 +684,17 @@ type DeploymentPayload struct {
 		Type              string `json:"type"`
 		SiteAdmin         bool   `json:"site_admin"`
 	} `json:"sender"`
+	Installation Installation `json:"installation"`
 }
 
-// DeploymentStatusPayload contains the information for GitHub's deployment_status hook event
-type DeploymentStatusPayload struct {
-	DeploymentStatus struct {
-		URL     string `json:"url"`
-		ID      int64  `json:"id"`
-		State   string `json:"state"`
-		Creator struct {
-			Login             string `json:"login"`
-			ID                int64  `json:"id"`
-			AvatarURL         string `json:"avatar_url"`
-			GravatarID        string `json:"gravatar_id"`
-			URL               string `json:"url"`
-			HTMLURL           string `json:"html_url"`
-			FollowersURL      string `json:"followers_url"`
-			FollowingURL      string `json:"following_url"`
-			GistsURL          string `json:"gists_url"`
-			StarredURL        string `json:"starred_url"`
-			SubscriptionsURL  string `json:"subscriptions_url"`
-			OrganizationsURL  string `json:"organizations_url"`
-			ReposURL          string `json:"repos_url"`
-			EventsURL         string `json:"events_url"`
-			ReceivedEventsURL string `json:"received_events_url"`
-			Type              string `json:"type"`
-			SiteAdmin         bool   `json:"site_admin"`
-		} `json:"creator"`
-		Description   *string   `json:"description"`
-		TargetURL     *string   `json:"target_url"`
-		CreatedAt     time.Time `json:"created_at"`
-		UpdatedAt     time.Time `json:"updated_at"`
-		DeploymentURL string    `json:"deployment_url"`
-		RepositoryURL string    `json:"repository_url"`
-	} `json:"deployment_status"`
-	Deployment struct {
-		URL     string `json:"url"`
-		ID      int64  `json:"id"`
-		Sha     string `json:"sha"`
-		Ref     string `json:"ref"`
-		Task    string `json:"task"`
-		Payload struct {
-		} `json:"payload"`
-		Environment string  `json:"environment"`
-		Description *string `json:"description"`
-		Creator     struct {
-			Login             string `json:"login"`
-			ID                int64  `json:"id"`
-			AvatarURL         string `json:"avatar_url"`
-			GravatarID        string `json:"gravatar_id"`
-			URL               string `json:"url"`
-			HTMLURL           string `json:"html_url"`
-			FollowersURL      string `json:"followers_url"`
-			FollowingURL      string `json:"following_url"`
-			GistsURL          string `json:"gists_url"`
-			StarredURL        string `json:"starred_url"`
-			SubscriptionsURL  string `json:"subscriptions_url"`
-			OrganizationsURL  string `json:"organizations_url"`
-			ReposURL          string `json:"repos_url"`
-			EventsURL         string `json:"events_url"`
-			ReceivedEventsURL string `json:"received_events_url"`
-			Type              string `json:"type"`
-			SiteAdmin         bool   `json:"site_admin"`
-		} `json:"creator"`
-		CreatedAt     time.Time `json:"created_at"`
-		UpdatedAt     time.Time `json:"updated_at"`
-		StatusesURL   string    `json:"statuses_url"`
-		RepositoryURL string    `json:"repository_url"`
-	} `json:"deployment"`
-	Repository struct {
+// CreatePayload contains the information for GitHub's create hook event
+type CreatePayload struct {
+	Ref          string `json:"ref"`
+	RefType      string `json:"ref_type"`
+	MasterBranch string `json:"master_branch"`
+	Description  string `json:"description"`
+	PusherType   string `json:"pusher_type"`
+	Repository   struct {
 		ID       int64  `json:"id"`
 		Name     string `json:"name"`
 		FullName string `json:"full_name"`
@@ -689,11 +800,15 @@ type DeploymentStatusPayload struct {
 		Type              string `json:"type"`
 		SiteAdmin         bool   `json:"site_admin"`
 	} `json:"sender"`
+	Installation Installation `json:"installation"`
 }
 
-// ForkPayload contains the information for GitHub's fork hook event
-type ForkPayload struct {
-	Forkee struct {
+// DeletePayload contains the information for GitHub's delete hook event
+type DeletePayload struct {
+	Ref        string `json:"ref"`
+	RefType    string `json:"ref_type"`
+	PusherType string `json:"pusher_type"`
+	Repository struct {
 		ID       int64  `json:"id"`
 		Name     string `json:"name"`
 		FullName string `json:"full_name"`
@@ -779,13 +894,42 @@ type ForkPayload struct {
 		OpenIssues       int64     `json:"open_issues"`
 		Watchers         int64     `json:"watchers"`
 		DefaultBranch    string    `json:"default_branch"`
-		Public           bool      `json:"public"`
-	} `json:"forkee"`
-	Repository struct {
-		ID       int64  `json:"id"`
-		Name     string `json:"name"`
-		FullName string `json:"full_name"`
-		Owner    struct {
+	} `json:"repository"`
+	Sender struct {
+		Login             string `json:"login"`
+		ID                int64  `json:"id"`
+		AvatarURL         string `json:"avatar_url"`
+		GravatarID        string `json:"gravatar_id"`
+		URL               string `json:"url"`
+		HTMLURL           string `json:"html_url"`
+		FollowersURL      string `json:"followers_url"`
+		FollowingURL      string `json:"following_url"`
+		GistsURL          string `json:"gists_url"`
+		StarredURL        string `json:"starred_url"`
+		SubscriptionsURL  string `json:"subscriptions_url"`
+		OrganizationsURL  string `json:"organizations_url"`
+		ReposURL          string `json:"repos_url"`
+		EventsURL         string `json:"events_url"`
+		ReceivedEventsURL string `json:"received_events_url"`
+		Type              string `json:"type"`
+		SiteAdmin         bool   `json:"site_admin"`
+	} `json:"sender"`
+	Installation Installation `json:"installation"`
+}
+
+// DeploymentPayload contains the information for GitHub's deployment hook
+type DeploymentPayload struct {
+	Deployment struct {
+		URL     string `json:"url"`
+		ID      int64  `json:"id"`
+		Sha     string `json:"sha"`
+		Ref     string `json:"ref"`
+		Task    string `json:"task"`
+		Payload struct {
+		} `json:"payload"`
+		Environment string  `json:"environment"`
+		Description *string `json:"description"`
+		Creator     struct {
 			Login             string `json:"login"`
 			ID                int64  `json:"id"`
 			AvatarURL         string `json:"avatar_url"`
@@ -803,15 +947,43 @@ type ForkPayload struct {
 			ReceivedEventsURL string `json:"received_events_url"`
 			Type              string `json:"type"`
 			SiteAdmin         bool   `json:"site_admin"`
-		} `json:"owner"`
-		Private          bool      `json:"private"`
-		HTMLURL          string    `json:"html_url"`
-		Description      string    `json:"description"`
-		Fork             bool      `json:"fork"`
-		URL              string    `json:"url"`
-		ForksURL         string    `json:"forks_url"`
-		KeysURL          string    `json:"keys_url"`
-		CollaboratorsURL string    `json:"collaborators_url"`
+		} `json:"creator"`
+		CreatedAt     time.Time `json:"created_at"`
+		UpdatedAt     time.Time `json:"updated_at"`
+		StatusesURL   string    `json:"statuses_url"`
+		RepositoryURL string    `json:"repository_url"`
+	} `json:"deployment"`
+	Repository struct {
+		ID       int64  `json:"id"`
+		Name     string `json:"name"`
+		FullName string `json:"full_name"`
+		Owner    struct {
+			Login             string `json:"login"`
+			ID                int64  `json:"id"`
+			AvatarURL         string `json:"avatar_url"`
+			GravatarID        string `json:"gravatar_id"`
+			URL               string `json:"url"`
+			HTMLURL           string `json:"html_url"`
+			FollowersURL      string `json:"followers_url"`
+			FollowingURL      string `json:"following_url"`
+			GistsURL          string `json:"gists_url"`
+			StarredURL        string `json:"starred_url"`
+			SubscriptionsURL  string `json:"subscriptions_url"`
+			OrganizationsURL  string `json:"organizations_url"`
+			ReposURL          string `json:"repos_url"`
+			EventsURL         string `json:"events_url"`
+			ReceivedEventsURL string `json:"received_events_url"`
+			Type              string `json:"type"`
+			SiteAdmin         bool   `json:"site_admin"`
+		} `json:"owner"`
+		Private          bool      `json:"private"`
+		HTMLURL          string    `json:"html_url"`
+		Description      string    `json:"description"`
+		Fork             bool      `json:"fork"`
+		URL              string    `json:"url"`
+		ForksURL         string    `json:"forks_url"`
+		KeysURL          string    `json:"keys_url"`
+		CollaboratorsURL string    `json:"collaborators_url"`
 		TeamsURL         string    `json:"teams_url"`
 		HooksURL         string    `json:"hooks_url"`
 		IssueEventsURL   string    `json:"issue_events_url"`
@@ -887,18 +1059,49 @@ type ForkPayload struct {
 		Type              string `json:"type"`
 		SiteAdmin         bool   `json:"site_admin"`
 	} `json:"sender"`
+	Installation Installation `json:"installation"`
 }
 
-// GollumPayload contains the information for GitHub's gollum hook event
-type GollumPayload struct {
-	Pages []struct {
-		PageName string  `json:"page_name"`
-		Title    string  `json:"title"`
-		Summary  *string `json:"summary"`
-		Action   string  `json:"action"`
-		Sha      string  `json:"sha"`
-		HTMLURL  string  `json:"html_url"`
-	} `json:"pages"`
+// DeploymentProtectionRulePayload contains the information for GitHub's deployment_protection_rule hook event
+type DeploymentProtectionRulePayload struct {
+	Action                string `json:"action"`
+	Environment           string `json:"environment"`
+	Event                 string `json:"event"`
+	DeploymentCallbackURL string `json:"deployment_callback_url"`
+	Deployment            struct {
+		URL     string `json:"url"`
+		ID      int64  `json:"id"`
+		Sha     string `json:"sha"`
+		Ref     string `json:"ref"`
+		Task    string `json:"task"`
+		Payload struct {
+		} `json:"payload"`
+		Environment string  `json:"environment"`
+		Description *string `json:"description"`
+		Creator     struct {
+			Login             string `json:"login"`
+			ID                int64  `json:"id"`
+			AvatarURL         string `json:"avatar_url"`
+			GravatarID        string `json:"gravatar_id"`
+			URL               string `json:"url"`
+			HTMLURL           string `json:"html_url"`
+			FollowersURL      string `json:"followers_url"`
+			FollowingURL      string `json:"following_url"`
+			GistsURL          string `json:"gists_url"`
+			StarredURL        string `json:"starred_url"`
+			SubscriptionsURL  string `json:"subscriptions_url"`
+			OrganizationsURL  string `json:"organizations_url"`
+			ReposURL          string `json:"repos_url"`
+			EventsURL         string `json:"events_url"`
+			ReceivedEventsURL string `json:"received_events_url"`
+			Type              string `json:"type"`
+			SiteAdmin         bool   `json:"site_admin"`
+		} `json:"creator"`
+		CreatedAt     time.Time `json:"created_at"`
+		UpdatedAt     time.Time `json:"updated_at"`
+		StatusesURL   string    `json:"statuses_url"`
+		RepositoryURL string    `json:"repository_url"`
+	} `json:"deployment"`
 	Repository struct {
 		ID       int64  `json:"id"`
 		Name     string `json:"name"`
@@ -1005,56 +1208,16 @@ type GollumPayload struct {
 		Type              string `json:"type"`
 		SiteAdmin         bool   `json:"site_admin"`
 	} `json:"sender"`
+	Installation Installation `json:"installation"`
 }
 
-// InstallationPayload contains the information for GitHub's installation and integration_installation hook events
-type InstallationPayload struct {
-	Action       string `json:"action"`
-	Installation struct {
-		ID      int64 `json:"id"`
-		Account struct {
-			Login             string `json:"login"`
-			ID                int64  `json:"id"`
-			AvatarURL         string `json:"avatar_url"`
-			GravatarID        string `json:"gravatar_id"`
-			URL               string `json:"url"`
-			HTMLURL           string `json:"html_url"`
-			FollowersURL      string `json:"followers_url"`
-			FollowingURL      string `json:"following_url"`
-			GistsURL          string `json:"gists_url"`
-			StarredURL        string `json:"starred_url"`
-			SubscriptionsURL  string `json:"subscriptions_url"`
-			OrganizationsURL  string `json:"organizations_url"`
-			ReposURL          string `json:"repos_url"`
-			EventsURL         string `json:"events_url"`
-			ReceivedEventsURL string `json:"received_events_url"`
-			Type              string `json:"type"`
-			SiteAdmin         bool   `json:"site_admin"`
-		} `json:"account"`
-		RepositorySelection string `json:"repository_selection"`
-		AccessTokensURL     string `json:"access_tokens_url"`
-		RepositoriesURL     string `json:"repositories_url"`
-		HTMLURL             string `json:"html_url"`
-		AppID               int    `json:"app_id"`
-		TargetID            int    `json:"target_id"`
-		TargetType          string `json:"target_type"`
-		Permissions         struct {
-			Issues             string `json:"issues"`
-			Metadata           string `json:"metadata"`
-			PullRequests       string `json:"pull_requests"`
-			RepositoryProjects string `json:"repository_projects"`
-		} `json:"permissions"`
-		Events         []string `json:"events"`
-		CreatedAt      int64    `json:"created_at"`
-		UpdatedAt      int64    `json:"updated_at"`
-		SingleFileName *string  `json:"single_file_name"`
-	} `json:"installation"`
-	Repositories []struct {
-		ID       int64  `json:"id"`
-		Name     string `json:"name"`
-		FullName string `json:"full_name"`
-	} `json:"repositories"`
-	Sender struct {
+// DeploymentReviewPayload contains the information for GitHub's deployment_review hook event
+type DeploymentReviewPayload struct {
+	Action      string    `json:"action"`
+	Since       time.Time `json:"since"`
+	Environment string    `json:"environment"`
+	Comment     string    `json:"comment"`
+	Approver    struct {
 		Login             string `json:"login"`
 		ID                int64  `json:"id"`
 		AvatarURL         string `json:"avatar_url"`
@@ -1072,61 +1235,10 @@ type InstallationPayload struct {
 		ReceivedEventsURL string `json:"received_events_url"`
 		Type              string `json:"type"`
 		SiteAdmin         bool   `json:"site_admin"`
-	} `json:"sender"`
-}
-
-// IssueCommentPayload contains the information for GitHub's issue_comment hook event
-type IssueCommentPayload struct {
-	Action string `json:"action"`
-	Issue  struct {
-		URL         string `json:"url"`
-		LabelsURL   string `json:"labels_url"`
-		CommentsURL string `json:"comments_url"`
-		EventsURL   string `json:"events_url"`
-		HTMLURL     string `json:"html_url"`
-		ID          int64  `json:"id"`
-		Number      int64  `json:"number"`
-		Title       string `json:"title"`
-		User        struct {
-			Login             string `json:"login"`
-			ID                int64  `json:"id"`
-			AvatarURL         string `json:"avatar_url"`
-			GravatarID        string `json:"gravatar_id"`
-			URL               string `json:"url"`
-			HTMLURL           string `json:"html_url"`
-			FollowersURL      string `json:"followers_url"`
-			FollowingURL      string `json:"following_url"`
-			GistsURL          string `json:"gists_url"`
-			StarredURL        string `json:"starred_url"`
-			SubscriptionsURL  string `json:"subscriptions_url"`
-			OrganizationsURL  string `json:"organizations_url"`
-			ReposURL          string `json:"repos_url"`
-			EventsURL         string `json:"events_url"`
-			ReceivedEventsURL string `json:"received_events_url"`
-			Type              string `json:"type"`
-			SiteAdmin         bool   `json:"site_admin"`
-		} `json:"user"`
-		Labels []struct {
-			URL   string `json:"url"`
-			Name  string `json:"name"`
-			Color string `json:"color"`
-		} `json:"labels"`
-		State     string     `json:"state"`
-		Locked    bool       `json:"locked"`
-		Assignee  *Assignee  `json:"assignee"`
-		Milestone *Milestone `json:"milestone"`
-		Comments  int64      `json:"comments"`
-		CreatedAt time.Time  `json:"created_at"`
-		UpdatedAt time.Time  `json:"updated_at"`
-		ClosedAt  *time.Time `json:"closed_at"`
-		Body      string     `json:"body"`
-	} `json:"issue"`
-	Comment struct {
-		URL      string `json:"url"`
-		HTMLURL  string `json:"html_url"`
-		IssueURL string `json:"issue_url"`
-		ID       int64  `json:"id"`
-		User     struct {
+	} `json:"approver"`
+	Reviewers []struct {
+		Type     string `json:"type"`
+		Reviewer struct {
 			Login             string `json:"login"`
 			ID                int64  `json:"id"`
 			AvatarURL         string `json:"avatar_url"`
@@ -1144,12 +1256,32 @@ type IssueCommentPayload struct {
 			ReceivedEventsURL string `json:"received_events_url"`
 			Type              string `json:"type"`
 			SiteAdmin         bool   `json:"site_admin"`
-		} `json:"user"`
-		CreatedAt         time.Time `json:"created_at"`
-		UpdatedAt         time.Time `json:"updated_at"`
-		Body              string    `json:"body"`
-		AuthorAssociation string    `json:"author_association"`
-	} `json:"comment"`
+		} `json:"reviewer"`
+	} `json:"reviewers"`
+	WorkflowRun struct {
+		ID         int64     `json:"id"`
+		Name       string    `json:"name"`
+		HeadBranch string    `json:"head_branch"`
+		HeadSha    string    `json:"head_sha"`
+		RunNumber  int64     `json:"run_number"`
+		Event      string    `json:"event"`
+		Status     string    `json:"status"`
+		Conclusion *string   `json:"conclusion"`
+		WorkflowID int64     `json:"workflow_id"`
+		HTMLURL    string    `json:"html_url"`
+		CreatedAt  time.Time `json:"created_at"`
+		UpdatedAt  time.Time `json:"updated_at"`
+	} `json:"workflow_run"`
+	WorkflowJobRuns []struct {
+		ID          int64     `json:"id"`
+		Name        string    `json:"name"`
+		Status      string    `json:"status"`
+		Conclusion  *string   `json:"conclusion"`
+		Environment string    `json:"environment"`
+		HTMLURL     string    `json:"html_url"`
+		CreatedAt   time.Time `json:"created_at"`
+		UpdatedAt   time.Time `json:"updated_at"`
+	} `json:"workflow_job_runs"`
 	Repository struct {
 		ID       int64  `json:"id"`
 		Name     string `json:"name"`
@@ -1256,21 +1388,16 @@ type IssueCommentPayload struct {
 		Type              string `json:"type"`
 		SiteAdmin         bool   `json:"site_admin"`
 	} `json:"sender"`
+	Installation Installation `json:"installation"`
 }
 
-// IssuesPayload contains the information for GitHub's issues hook event
-type IssuesPayload struct {
-	Action string `json:"action"`
-	Issue  struct {
-		URL         string `json:"url"`
-		LabelsURL   string `json:"labels_url"`
-		CommentsURL string `json:"comments_url"`
-		EventsURL   string `json:"events_url"`
-		HTMLURL     string `json:"html_url"`
-		ID          int64  `json:"id"`
-		Number      int64  `json:"number"`
-		Title       string `json:"title"`
-		User        struct {
+// DeploymentStatusPayload contains the information for GitHub's deployment_status hook event
+type DeploymentStatusPayload struct {
+	DeploymentStatus struct {
+		URL     string `json:"url"`
+		ID      int64  `json:"id"`
+		State   string `json:"state"`
+		Creator struct {
 			Login             string `json:"login"`
 			ID                int64  `json:"id"`
 			AvatarURL         string `json:"avatar_url"`
@@ -1288,29 +1415,25 @@ type IssuesPayload struct {
 			ReceivedEventsURL string `json:"received_events_url"`
 			Type              string `json:"type"`
 			SiteAdmin         bool   `json:"site_admin"`
-		} `json:"user"`
-		Labels []struct {
-			ID      int64  `json:"id"`
-			URL     string `json:"url"`
-			Name    string `json:"name"`
-			Color   string `json:"color"`
-			Default bool   `json:"default"`
-		} `json:"labels"`
-		State     string     `json:"state"`
-		Locked    bool       `json:"locked"`
-		Assignee  *Assignee  `json:"assignee"`
-		Milestone *Milestone `json:"milestone"`
-		Comments  int64      `json:"comments"`
-		CreatedAt time.Time  `json:"created_at"`
-		UpdatedAt time.Time  `json:"updated_at"`
-		ClosedAt  *time.Time `json:"closed_at"`
-		Body      string     `json:"body"`
-	} `json:"issue"`
-	Repository struct {
-		ID       int64  `json:"id"`
-		Name     string `json:"name"`
-		FullName string `json:"full_name"`
-		Owner    struct {
+		} `json:"creator"`
+		Description   *string   `json:"description"`
+		TargetURL     *string   `json:"target_url"`
+		CreatedAt     time.Time `json:"created_at"`
+		UpdatedAt     time.Time `json:"updated_at"`
+		DeploymentURL string    `json:"deployment_url"`
+		RepositoryURL string    `json:"repository_url"`
+	} `json:"deployment_status"`
+	Deployment struct {
+		URL     string `json:"url"`
+		ID      int64  `json:"id"`
+		Sha     string `json:"sha"`
+		Ref     string `json:"ref"`
+		Task    string `json:"task"`
+		Payload struct {
+		} `json:"payload"`
+		Environment string  `json:"environment"`
+		Description *string `json:"description"`
+		Creator     struct {
 			Login             string `json:"login"`
 			ID                int64  `json:"id"`
 			AvatarURL         string `json:"avatar_url"`
@@ -1328,10 +1451,38 @@ type IssuesPayload struct {
 			ReceivedEventsURL string `json:"received_events_url"`
 			Type              string `json:"type"`
 			SiteAdmin         bool   `json:"site_admin"`
-		} `json:"owner"`
-		Private          bool      `json:"private"`
-		HTMLURL          string    `json:"html_url"`
-		Description      string    `json:"description"`
+		} `json:"creator"`
+		CreatedAt     time.Time `json:"created_at"`
+		UpdatedAt     time.Time `json:"updated_at"`
+		StatusesURL   string    `json:"statuses_url"`
+		RepositoryURL string    `json:"repository_url"`
+	} `json:"deployment"`
+	Repository struct {
+		ID       int64  `json:"id"`
+		Name     string `json:"name"`
+		FullName string `json:"full_name"`
+		Owner    struct {
+			Login             string `json:"login"`
+			ID                int64  `json:"id"`
+			AvatarURL         string `json:"avatar_url"`
+			GravatarID        string `json:"gravatar_id"`
+			URL               string `json:"url"`
+			HTMLURL           string `json:"html_url"`
+			FollowersURL      string `json:"followers_url"`
+			FollowingURL      string `json:"following_url"`
+			GistsURL          string `json:"gists_url"`
+			StarredURL        string `json:"starred_url"`
+			SubscriptionsURL  string `json:"subscriptions_url"`
+			OrganizationsURL  string `json:"organizations_url"`
+			ReposURL          string `json:"repos_url"`
+			EventsURL         string `json:"events_url"`
+			ReceivedEventsURL string `json:"received_events_url"`
+			Type              string `json:"type"`
+			SiteAdmin         bool   `json:"site_admin"`
+		} `json:"owner"`
+		Private          bool      `json:"private"`
+		HTMLURL          string    `json:"html_url"`
+		Description      string    `json:"description"`
 		Fork             bool      `json:"fork"`
 		URL              string    `json:"url"`
 		ForksURL         string    `json:"forks_url"`
@@ -1412,16 +1563,65 @@ type IssuesPayload struct {
 		Type              string `json:"type"`
 		SiteAdmin         bool   `json:"site_admin"`
 	} `json:"sender"`
+	Installation Installation `json:"installation"`
 }
 
-// LabelPayload contains the information for GitHub's label hook event
-type LabelPayload struct {
-	Action string `json:"action"`
-	Label  struct {
-		URL   string `json:"url"`
-		Name  string `json:"name"`
-		Color string `json:"color"`
-	} `json:"label"`
+// DiscussionPayload contains the information for GitHub's discussion hook event
+type DiscussionPayload struct {
+	Action     string `json:"action"`
+	Discussion struct {
+		RepositoryURL string `json:"repository_url"`
+		Category      struct {
+			ID           int64     `json:"id"`
+			NodeID       string    `json:"node_id"`
+			RepositoryID int64     `json:"repository_id"`
+			Emoji        string    `json:"emoji"`
+			Name         string    `json:"name"`
+			Description  string    `json:"description"`
+			CreatedAt    time.Time `json:"created_at"`
+			UpdatedAt    time.Time `json:"updated_at"`
+			Slug         string    `json:"slug"`
+			IsAnswerable bool      `json:"is_answerable"`
+		} `json:"category"`
+		AnswerHTMLURL  *string    `json:"answer_html_url"`
+		AnswerChosenAt *time.Time `json:"answer_chosen_at"`
+		AnswerChosenBy *struct {
+			Login string `json:"login"`
+			ID    int64  `json:"id"`
+		} `json:"answer_chosen_by"`
+		HTMLURL string `json:"html_url"`
+		ID      int64  `json:"id"`
+		NodeID  string `json:"node_id"`
+		Number  int64  `json:"number"`
+		Title   string `json:"title"`
+		User    struct {
+			Login             string `json:"login"`
+			ID                int64  `json:"id"`
+			AvatarURL         string `json:"avatar_url"`
+			GravatarID        string `json:"gravatar_id"`
+			URL               string `json:"url"`
+			HTMLURL           string `json:"html_url"`
+			FollowersURL      string `json:"followers_url"`
+			FollowingURL      string `json:"following_url"`
+			GistsURL          string `json:"gists_url"`
+			StarredURL        string `json:"starred_url"`
+			SubscriptionsURL  string `json:"subscriptions_url"`
+			OrganizationsURL  string `json:"organizations_url"`
+			ReposURL          string `json:"repos_url"`
+			EventsURL         string `json:"events_url"`
+			ReceivedEventsURL string `json:"received_events_url"`
+			Type              string `json:"type"`
+			SiteAdmin         bool   `json:"site_admin"`
+		} `json:"user"`
+		State             string    `json:"state"`
+		Locked            bool      `json:"locked"`
+		Comments          int64     `json:"comments"`
+		CreatedAt         time.Time `json:"created_at"`
+		UpdatedAt         time.Time `json:"updated_at"`
+		AuthorAssociation string    `json:"author_association"`
+		ActiveLockReason  *string   `json:"active_lock_reason"`
+		Body              string    `json:"body"`
+	} `json:"discussion"`
 	Repository struct {
 		ID       int64  `json:"id"`
 		Name     string `json:"name"`
@@ -1447,7 +1647,7 @@ type LabelPayload struct {
 		} `json:"owner"`
 		Private          bool      `json:"private"`
 		HTMLURL          string    `json:"html_url"`
-		Description      *string   `json:"description"`
+		Description      string    `json:"description"`
 		Fork             bool      `json:"fork"`
 		URL              string    `json:"url"`
 		ForksURL         string    `json:"forks_url"`
@@ -1485,7 +1685,6 @@ type LabelPayload struct {
 		NotificationsURL string    `json:"notifications_url"`
 		LabelsURL        string    `json:"labels_url"`
 		ReleasesURL      string    `json:"releases_url"`
-		DeploymentsURL   string    `json:"deployments_url"`
 		CreatedAt        time.Time `json:"created_at"`
 		UpdatedAt        time.Time `json:"updated_at"`
 		PushedAt         time.Time `json:"pushed_at"`
@@ -1510,19 +1709,6 @@ type LabelPayload struct {
 		Watchers         int64     `json:"watchers"`
 		DefaultBranch    string    `json:"default_branch"`
 	} `json:"repository"`
-	Organization struct {
-		Login            string `json:"login"`
-		ID               int64  `json:"id"`
-		URL              string `json:"url"`
-		ReposURL         string `json:"repos_url"`
-		EventsURL        string `json:"events_url"`
-		HooksURL         string `json:"hooks_url"`
-		IssuesURL        string `json:"issues_url"`
-		MembersURL       string `json:"members_url"`
-		PublicMembersURL string `json:"public_members_url"`
-		AvatarURL        string `json:"avatar_url"`
-		Description      string `json:"description"`
-	} `json:"organization"`
 	Sender struct {
 		Login             string `json:"login"`
 		ID                int64  `json:"id"`
@@ -1542,30 +1728,97 @@ type LabelPayload struct {
 		Type              string `json:"type"`
 		SiteAdmin         bool   `json:"site_admin"`
 	} `json:"sender"`
+	Installation Installation `json:"installation"`
 }
 
-// MemberPayload contains the information for GitHub's member hook event
-type MemberPayload struct {
-	Action string `json:"action"`
-	Member struct {
-		Login             string `json:"login"`
-		ID                int64  `json:"id"`
-		AvatarURL         string `json:"avatar_url"`
-		GravatarID        string `json:"gravatar_id"`
-		URL               string `json:"url"`
-		HTMLURL           string `json:"html_url"`
-		FollowersURL      string `json:"followers_url"`
-		FollowingURL      string `json:"following_url"`
-		GistsURL          string `json:"gists_url"`
-		StarredURL        string `json:"starred_url"`
-		SubscriptionsURL  string `json:"subscriptions_url"`
-		OrganizationsURL  string `json:"organizations_url"`
-		ReposURL          string `json:"repos_url"`
-		EventsURL         string `json:"events_url"`
-		ReceivedEventsURL string `json:"received_events_url"`
-		Type              string `json:"type"`
-		SiteAdmin         bool   `json:"site_admin"`
-	} `json:"member"`
+// DiscussionCommentPayload contains the information for GitHub's discussion_comment hook event
+type DiscussionCommentPayload struct {
+	Action  string `json:"action"`
+	Comment struct {
+		ID                int64     `json:"id"`
+		NodeID            string    `json:"node_id"`
+		HTMLURL           string    `json:"html_url"`
+		ParentID          *int64    `json:"parent_id"`
+		ChildCommentCount int64     `json:"child_comment_count"`
+		RepositoryURL     string    `json:"repository_url"`
+		DiscussionID      int64     `json:"discussion_id"`
+		AuthorAssociation string    `json:"author_association"`
+		CreatedAt         time.Time `json:"created_at"`
+		UpdatedAt         time.Time `json:"updated_at"`
+		Body              string    `json:"body"`
+		User              struct {
+			Login             string `json:"login"`
+			ID                int64  `json:"id"`
+			AvatarURL         string `json:"avatar_url"`
+			GravatarID        string `json:"gravatar_id"`
+			URL               string `json:"url"`
+			HTMLURL           string `json:"html_url"`
+			FollowersURL      string `json:"followers_url"`
+			FollowingURL      string `json:"following_url"`
+			GistsURL          string `json:"gists_url"`
+			StarredURL        string `json:"starred_url"`
+			SubscriptionsURL  string `json:"subscriptions_url"`
+			OrganizationsURL  string `json:"organizations_url"`
+			ReposURL          string `json:"repos_url"`
+			EventsURL         string `json:"events_url"`
+			ReceivedEventsURL string `json:"received_events_url"`
+			Type              string `json:"type"`
+			SiteAdmin         bool   `json:"site_admin"`
+		} `json:"user"`
+	} `json:"comment"`
+	Discussion struct {
+		RepositoryURL string `json:"repository_url"`
+		Category      struct {
+			ID           int64     `json:"id"`
+			NodeID       string    `json:"node_id"`
+			RepositoryID int64     `json:"repository_id"`
+			Emoji        string    `json:"emoji"`
+			Name         string    `json:"name"`
+			Description  string    `json:"description"`
+			CreatedAt    time.Time `json:"created_at"`
+			UpdatedAt    time.Time `json:"updated_at"`
+			Slug         string    `json:"slug"`
+			IsAnswerable bool      `json:"is_answerable"`
+		} `json:"category"`
+		AnswerHTMLURL  *string    `json:"answer_html_url"`
+		AnswerChosenAt *time.Time `json:"answer_chosen_at"`
+		AnswerChosenBy *struct {
+			Login string `json:"login"`
+			ID    int64  `json:"id"`
+		} `json:"answer_chosen_by"`
+		HTMLURL string `json:"html_url"`
+		ID      int64  `json:"id"`
+		NodeID  string `json:"node_id"`
+		Number  int64  `json:"number"`
+		Title   string `json:"title"`
+		User    struct {
+			Login             string `json:"login"`
+			ID                int64  `json:"id"`
+			AvatarURL         string `json:"avatar_url"`
+			GravatarID        string `json:"gravatar_id"`
+			URL               string `json:"url"`
+			HTMLURL           string `json:"html_url"`
+			FollowersURL      string `json:"followers_url"`
+			FollowingURL      string `json:"following_url"`
+			GistsURL          string `json:"gists_url"`
+			StarredURL        string `json:"starred_url"`
+			SubscriptionsURL  string `json:"subscriptions_url"`
+			OrganizationsURL  string `json:"organizations_url"`
+			ReposURL          string `json:"repos_url"`
+			EventsURL         string `json:"events_url"`
+			ReceivedEventsURL string `json:"received_events_url"`
+			Type              string `json:"type"`
+			SiteAdmin         bool   `json:"site_admin"`
+		} `json:"user"`
+		State             string    `json:"state"`
+		Locked            bool      `json:"locked"`
+		Comments          int64     `json:"comments"`
+		CreatedAt         time.Time `json:"created_at"`
+		UpdatedAt         time.Time `json:"updated_at"`
+		AuthorAssociation string    `json:"author_association"`
+		ActiveLockReason  *string   `json:"active_lock_reason"`
+		Body              string    `json:"body"`
+	} `json:"discussion"`
 	Repository struct {
 		ID       int64  `json:"id"`
 		Name     string `json:"name"`
@@ -1672,114 +1925,16 @@ type MemberPayload struct {
 		Type              string `json:"type"`
 		SiteAdmin         bool   `json:"site_admin"`
 	} `json:"sender"`
+	Installation Installation `json:"installation"`
 }
 
-// MembershipPayload contains the information for GitHub's membership hook event
-type MembershipPayload struct {
-	Action string `json:"action"`
-	Scope  string `json:"scope"`
-	Member struct {
-		Login             string `json:"login"`
-		ID                int64  `json:"id"`
-		AvatarURL         string `json:"avatar_url"`
-		GravatarID        string `json:"gravatar_id"`
-		URL               string `json:"url"`
-		HTMLURL           string `json:"html_url"`
-		FollowersURL      string `json:"followers_url"`
-		FollowingURL      string `json:"following_url"`
-		GistsURL          string `json:"gists_url"`
-		StarredURL        string `json:"starred_url"`
-		SubscriptionsURL  string `json:"subscriptions_url"`
-		OrganizationsURL  string `json:"organizations_url"`
-		ReposURL          string `json:"repos_url"`
-		EventsURL         string `json:"events_url"`
-		ReceivedEventsURL string `json:"received_events_url"`
-		Type              string `json:"type"`
-		SiteAdmin         bool   `json:"site_admin"`
-	} `json:"member"`
-	Sender struct {
-		Login             string `json:"login"`
-		ID                int64  `json:"id"`
-		AvatarURL         string `json:"avatar_url"`
-		GravatarID        string `json:"gravatar_id"`
-		URL               string `json:"url"`
-		HTMLURL           string `json:"html_url"`
-		FollowersURL      string `json:"followers_url"`
-		FollowingURL      string `json:"following_url"`
-		GistsURL          string `json:"gists_url"`
-		StarredURL        string `json:"starred_url"`
-		SubscriptionsURL  string `json:"subscriptions_url"`
-		OrganizationsURL  string `json:"organizations_url"`
-		ReposURL          string `json:"repos_url"`
-		EventsURL         string `json:"events_url"`
-		ReceivedEventsURL string `json:"received_events_url"`
-		Type              string `json:"type"`
-		SiteAdmin         bool   `json:"site_admin"`
-	} `json:"sender"`
-	Team struct {
-		Name            string `json:"name"`
-		ID              int64  `json:"id"`
-		Slug            string `json:"slug"`
-		Permission      string `json:"permission"`
-		URL             string `json:"url"`
-		MembersURL      string `json:"members_url"`
-		RepositoriesURL string `json:"repositories_url"`
-	} `json:"team"`
-	Organization struct {
-		Login            string `json:"login"`
-		ID               int64  `json:"id"`
-		URL              string `json:"url"`
-		ReposURL         string `json:"repos_url"`
-		EventsURL        string `json:"events_url"`
-		MembersURL       string `json:"members_url"`
-		PublicMembersURL string `json:"public_members_url"`
-		AvatarURL        string `json:"avatar_url"`
-	} `json:"organization"`
-}
-
-// MilestonePayload contains the information for GitHub's milestone hook event
-type MilestonePayload struct {
-	Action    string `json:"action"`
-	Milestone struct {
-		URL         string  `json:"url"`
-		HTMLURL     string  `json:"html_url"`
-		LabelsURL   string  `json:"labels_url"`
-		ID          int64   `json:"id"`
-		Number      int64   `json:"number"`
-		Title       string  `json:"title"`
-		Description *string `json:"description"`
-		Creator     struct {
-			Login             string `json:"login"`
-			ID                int64  `json:"id"`
-			AvatarURL         string `json:"avatar_url"`
-			GravatarID        string `json:"gravatar_id"`
-			URL               string `json:"url"`
-			HTMLURL           string `json:"html_url"`
-			FollowersURL      string `json:"followers_url"`
-			FollowingURL      string `json:"following_url"`
-			GistsURL          string `json:"gists_url"`
-			StarredURL        string `json:"starred_url"`
-			SubscriptionsURL  string `json:"subscriptions_url"`
-			OrganizationsURL  string `json:"organizations_url"`
-			ReposURL          string `json:"repos_url"`
-			EventsURL         string `json:"events_url"`
-			ReceivedEventsURL string `json:"received_events_url"`
-			Type              string `json:"type"`
-			SiteAdmin         bool   `json:"site_admin"`
-		} `json:"creator"`
-		OpenIssues   int64      `json:"open_issues"`
-		ClosedIssues int64      `json:"closed_issues"`
-		State        string     `json:"state"`
-		CreatedAt    time.Time  `json:"created_at"`
-		UpdatedAt    time.Time  `json:"updated_at"`
-		DueOn        *time.Time `json:"due_on"`
-		ClosedAt     *time.Time `json:"closed_at"`
-	} `json:"milestone"`
-	Repository struct {
-		ID       int64  `json:"id"`
-		Name     string `json:"name"`
-		FullName string `json:"full_name"`
-		Owner    struct {
+// ForkPayload contains the information for GitHub's fork hook event
+type ForkPayload struct {
+	Forkee struct {
+		ID       int64  `json:"id"`
+		Name     string `json:"name"`
+		FullName string `json:"full_name"`
+		Owner    struct {
 			Login             string `json:"login"`
 			ID                int64  `json:"id"`
 			AvatarURL         string `json:"avatar_url"`
@@ -1800,7 +1955,7 @@ type MilestonePayload struct {
 		} `json:"owner"`
 		Private          bool      `json:"private"`
 		HTMLURL          string    `json:"html_url"`
-		Description      *string   `json:"description"`
+		Description      string    `json:"description"`
 		Fork             bool      `json:"fork"`
 		URL              string    `json:"url"`
 		ForksURL         string    `json:"forks_url"`
@@ -1838,7 +1993,6 @@ type MilestonePayload struct {
 		NotificationsURL string    `json:"notifications_url"`
 		LabelsURL        string    `json:"labels_url"`
 		ReleasesURL      string    `json:"releases_url"`
-		DeploymentsURL   string    `json:"deployments_url"`
 		CreatedAt        time.Time `json:"created_at"`
 		UpdatedAt        time.Time `json:"updated_at"`
 		PushedAt         time.Time `json:"pushed_at"`
@@ -1862,56 +2016,13 @@ type MilestonePayload struct {
 		OpenIssues       int64     `json:"open_issues"`
 		Watchers         int64     `json:"watchers"`
 		DefaultBranch    string    `json:"default_branch"`
-	} `json:"repository"`
-	Organization struct {
-		Login            string `json:"login"`
-		ID               int64  `json:"id"`
-		URL              string `json:"url"`
-		ReposURL         string `json:"repos_url"`
-		EventsURL        string `json:"events_url"`
-		HooksURL         string `json:"hooks_url"`
-		IssuesURL        string `json:"issues_url"`
-		MembersURL       string `json:"members_url"`
-		PublicMembersURL string `json:"public_members_url"`
-		AvatarURL        string `json:"avatar_url"`
-		Description      string `json:"description"`
-	} `json:"organization"`
-	Sender struct {
-		Login             string `json:"login"`
-		ID                int64  `json:"id"`
-		AvatarURL         string `json:"avatar_url"`
-		GravatarID        string `json:"gravatar_id"`
-		URL               string `json:"url"`
-		HTMLURL           string `json:"html_url"`
-		FollowersURL      string `json:"followers_url"`
-		FollowingURL      string `json:"following_url"`
-		GistsURL          string `json:"gists_url"`
-		StarredURL        string `json:"starred_url"`
-		SubscriptionsURL  string `json:"subscriptions_url"`
-		OrganizationsURL  string `json:"organizations_url"`
-		ReposURL          string `json:"repos_url"`
-		EventsURL         string `json:"events_url"`
-		ReceivedEventsURL string `json:"received_events_url"`
-		Type              string `json:"type"`
-		SiteAdmin         bool   `json:"site_admin"`
-	} `json:"sender"`
-}
-
-// OrganizationPayload contains the information for GitHub's organization hook event
-type OrganizationPayload struct {
-	Action     string `json:"action"`
-	Invitation struct {
-		ID    int64   `json:"id"`
-		Login string  `json:"login"`
-		Email *string `json:"email"`
-		Role  string  `json:"role"`
-	} `json:"invitation"`
-	Membership struct {
-		URL             string `json:"url"`
-		State           string `json:"state"`
-		Role            string `json:"role"`
-		OrganizationURL string `json:"organization_url"`
-		User            struct {
+		Public           bool      `json:"public"`
+	} `json:"forkee"`
+	Repository struct {
+		ID       int64  `json:"id"`
+		Name     string `json:"name"`
+		FullName string `json:"full_name"`
+		Owner    struct {
 			Login             string `json:"login"`
 			ID                int64  `json:"id"`
 			AvatarURL         string `json:"avatar_url"`
@@ -1929,21 +2040,71 @@ type OrganizationPayload struct {
 			ReceivedEventsURL string `json:"received_events_url"`
 			Type              string `json:"type"`
 			SiteAdmin         bool   `json:"site_admin"`
-		} `json:"user"`
-	} `json:"membership"`
-	Organization struct {
-		Login            string `json:"login"`
-		ID               int64  `json:"id"`
-		URL              string `json:"url"`
-		ReposURL         string `json:"repos_url"`
-		EventsURL        string `json:"events_url"`
-		HooksURL         string `json:"hooks_url"`
-		IssuesURL        string `json:"issues_url"`
-		MembersURL       string `json:"members_url"`
-		PublicMembersURL string `json:"public_members_url"`
-		AvatarURL        string `json:"avatar_url"`
-		Description      string `json:"description"`
-	} `json:"organization"`
+		} `json:"owner"`
+		Private          bool      `json:"private"`
+		HTMLURL          string    `json:"html_url"`
+		Description      string    `json:"description"`
+		Fork             bool      `json:"fork"`
+		URL              string    `json:"url"`
+		ForksURL         string    `json:"forks_url"`
+		KeysURL          string    `json:"keys_url"`
+		CollaboratorsURL string    `json:"collaborators_url"`
+		TeamsURL         string    `json:"teams_url"`
+		HooksURL         string    `json:"hooks_url"`
+		IssueEventsURL   string    `json:"issue_events_url"`
+		EventsURL        string    `json:"events_url"`
+		AssigneesURL     string    `json:"assignees_url"`
+		BranchesURL      string    `json:"branches_url"`
+		TagsURL          string    `json:"tags_url"`
+		BlobsURL         string    `json:"blobs_url"`
+		GitTagsURL       string    `json:"git_tags_url"`
+		GitRefsURL       string    `json:"git_refs_url"`
+		TreesURL         string    `json:"trees_url"`
+		StatusesURL      string    `json:"statuses_url"`
+		LanguagesURL     string    `json:"languages_url"`
+		StargazersURL    string    `json:"stargazers_url"`
+		ContributorsURL  string    `json:"contributors_url"`
+		SubscribersURL   string    `json:"subscribers_url"`
+		SubscriptionURL  string    `json:"subscription_url"`
+		CommitsURL       string    `json:"commits_url"`
+		GitCommitsURL    string    `json:"git_commits_url"`
+		CommentsURL      string    `json:"comments_url"`
+		IssueCommentURL  string    `json:"issue_comment_url"`
+		ContentsURL      string    `json:"contents_url"`
+		CompareURL       string    `json:"compare_url"`
+		MergesURL        string    `json:"merges_url"`
+		ArchiveURL       string    `json:"archive_url"`
+		DownloadsURL     string    `json:"downloads_url"`
+		IssuesURL        string    `json:"issues_url"`
+		PullsURL         string    `json:"pulls_url"`
+		MilestonesURL    string    `json:"milestones_url"`
+		NotificationsURL string    `json:"notifications_url"`
+		LabelsURL        string    `json:"labels_url"`
+		ReleasesURL      string    `json:"releases_url"`
+		CreatedAt        time.Time `json:"created_at"`
+		UpdatedAt        time.Time `json:"updated_at"`
+		PushedAt         time.Time `json:"pushed_at"`
+		GitURL           string    `json:"git_url"`
+		SSHURL           string    `json:"ssh_url"`
+		CloneURL         string    `json:"clone_url"`
+		SvnURL           string    `json:"svn_url"`
+		Homepage         *string   `json:"homepage"`
+		Size             int64     `json:"size"`
+		StargazersCount  int64     `json:"stargazers_count"`
+		WatchersCount    int64     `json:"watchers_count"`
+		Language         *string   `json:"language"`
+		HasIssues        bool      `json:"has_issues"`
+		HasDownloads     bool      `json:"has_downloads"`
+		HasWiki          bool      `json:"has_wiki"`
+		HasPages         bool      `json:"has_pages"`
+		ForksCount       int64     `json:"forks_count"`
+		MirrorURL        *string   `json:"mirror_url"`
+		OpenIssuesCount  int64     `json:"open_issues_count"`
+		Forks            int64     `json:"forks"`
+		OpenIssues       int64     `json:"open_issues"`
+		Watchers         int64     `json:"watchers"`
+		DefaultBranch    string    `json:"default_branch"`
+	} `json:"repository"`
 	Sender struct {
 		Login             string `json:"login"`
 		ID                int64  `json:"id"`
@@ -1963,43 +2124,13 @@ type OrganizationPayload struct {
 		Type              string `json:"type"`
 		SiteAdmin         bool   `json:"site_admin"`
 	} `json:"sender"`
+	Installation Installation `json:"installation"`
 }
 
-// OrgBlockPayload contains the information for GitHub's org_block hook event
-type OrgBlockPayload struct {
-	Action      string `json:"action"`
-	BlockedUser struct {
-		Login             string `json:"login"`
-		ID                int64  `json:"id"`
-		AvatarURL         string `json:"avatar_url"`
-		GravatarID        string `json:"gravatar_id"`
-		URL               string `json:"url"`
-		HTMLURL           string `json:"html_url"`
-		FollowersURL      string `json:"followers_url"`
-		FollowingURL      string `json:"following_url"`
-		GistsURL          string `json:"gists_url"`
-		StarredURL        string `json:"starred_url"`
-		SubscriptionsURL  string `json:"subscriptions_url"`
-		OrganizationsURL  string `json:"organizations_url"`
-		ReposURL          string `json:"repos_url"`
-		EventsURL         string `json:"events_url"`
-		ReceivedEventsURL string `json:"received_events_url"`
-		Type              string `json:"type"`
-		SiteAdmin         bool   `json:"site_admin"`
-	} `json:"blocked_user"`
-	Organization struct {
-		Login            string `json:"login"`
-		ID               int64  `json:"id"`
-		URL              string `json:"url"`
-		ReposURL         string `json:"repos_url"`
-		EventsURL        string `json:"events_url"`
-		HooksURL         string `json:"hooks_url"`
-		IssuesURL        string `json:"issues_url"`
-		MembersURL       string `json:"members_url"`
-		PublicMembersURL string `json:"public_members_url"`
-		AvatarURL        string `json:"avatar_url"`
-		Description      string `json:"description"`
-	} `json:"organization"`
+// GithubAppAuthorizationPayload contains the information for GitHub's github_app_authorization hook event,
+// sent when the sender revokes their authorization of the GitHub App
+type GithubAppAuthorizationPayload struct {
+	Action string `json:"action"`
 	Sender struct {
 		Login             string `json:"login"`
 		ID                int64  `json:"id"`
@@ -2021,39 +2152,16 @@ type OrgBlockPayload struct {
 	} `json:"sender"`
 }
 
-// PageBuildPayload contains the information for GitHub's page_build hook event
-type PageBuildPayload struct {
-	ID    int64 `json:"id"`
-	Build struct {
-		URL    string `json:"url"`
-		Status string `json:"status"`
-		Error  struct {
-			Message *string `json:"message"`
-		} `json:"error"`
-		Pusher struct {
-			Login             string `json:"login"`
-			ID                int64  `json:"id"`
-			AvatarURL         string `json:"avatar_url"`
-			GravatarID        string `json:"gravatar_id"`
-			URL               string `json:"url"`
-			HTMLURL           string `json:"html_url"`
-			FollowersURL      string `json:"followers_url"`
-			FollowingURL      string `json:"following_url"`
-			GistsURL          string `json:"gists_url"`
-			StarredURL        string `json:"starred_url"`
-			SubscriptionsURL  string `json:"subscriptions_url"`
-			OrganizationsURL  string `json:"organizations_url"`
-			ReposURL          string `json:"repos_url"`
-			EventsURL         string `json:"events_url"`
-			ReceivedEventsURL string `json:"received_events_url"`
-			Type              string `json:"type"`
-			SiteAdmin         bool   `json:"site_admin"`
-		} `json:"pusher"`
-		Commit    string    `json:"commit"`
-		Duration  int64     `json:"duration"`
-		CreatedAt time.Time `json:"created_at"`
-		UpdatedAt time.Time `json:"updated_at"`
-	} `json:"build"`
+// GollumPayload contains the information for GitHub's gollum hook event
+type GollumPayload struct {
+	Pages []struct {
+		PageName string  `json:"page_name"`
+		Title    string  `json:"title"`
+		Summary  *string `json:"summary"`
+		Action   string  `json:"action"`
+		Sha      string  `json:"sha"`
+		HTMLURL  string  `json:"html_url"`
+	} `json:"pages"`
 	Repository struct {
 		ID       int64  `json:"id"`
 		Name     string `json:"name"`
@@ -2160,39 +2268,15 @@ type PageBuildPayload struct {
 		Type              string `json:"type"`
 		SiteAdmin         bool   `json:"site_admin"`
 	} `json:"sender"`
+	Installation Installation `json:"installation"`
 }
 
-// PingPayload contains the information for GitHub's ping hook event
-type PingPayload struct {
-	HookID int `json:"hook_id"`
-	Hook   struct {
-		Type   string   `json:"type"`
-		ID     int64    `json:"id"`
-		Name   string   `json:"name"`
-		Active bool     `json:"active"`
-		Events []string `json:"events"`
-		AppID  int      `json:"app_id"`
-		Config struct {
-			ContentType string `json:"content_type"`
-			InsecureSSL int    `json:"insecure_ssl"`
-			Secret      string `json:"secret"`
-			URL         string `json:"url"`
-		} `json:"config"`
-		CreatedAt time.Time `json:"created_at"`
-		UpdatedAt time.Time `json:"updated_at"`
-	} `json:"hook"`
-}
-
-// ProjectCardPayload contains the information for GitHub's project_payload hook event
-type ProjectCardPayload struct {
-	Action      string `json:"action"`
-	ProjectCard struct {
-		URL       string  `json:"url"`
-		ColumnURL string  `json:"column_url"`
-		ColumnID  int64   `json:"column_id"`
-		ID        int64   `json:"id"`
-		Note      *string `json:"note"`
-		Creator   struct {
+// InstallationPayload contains the information for GitHub's installation and integration_installation hook events
+type InstallationPayload struct {
+	Action       string `json:"action"`
+	Installation struct {
+		ID      int64 `json:"id"`
+		Account struct {
 			Login             string `json:"login"`
 			ID                int64  `json:"id"`
 			AvatarURL         string `json:"avatar_url"`
@@ -2210,11 +2294,230 @@ type ProjectCardPayload struct {
 			ReceivedEventsURL string `json:"received_events_url"`
 			Type              string `json:"type"`
 			SiteAdmin         bool   `json:"site_admin"`
-		} `json:"creator"`
-		CreatedAt  int64  `json:"created_at"`
-		UpdatedAt  int64  `json:"updated_at"`
-		ContentURL string `json:"content_url"`
-	} `json:"project_card"`
+		} `json:"account"`
+		RepositorySelection string `json:"repository_selection"`
+		AccessTokensURL     string `json:"access_tokens_url"`
+		RepositoriesURL     string `json:"repositories_url"`
+		HTMLURL             string `json:"html_url"`
+		AppID               int    `json:"app_id"`
+		TargetID            int    `json:"target_id"`
+		TargetType          string `json:"target_type"`
+		Permissions         struct {
+			Issues             string `json:"issues"`
+			Metadata           string `json:"metadata"`
+			PullRequests       string `json:"pull_requests"`
+			RepositoryProjects string `json:"repository_projects"`
+		} `json:"permissions"`
+		Events         []string `json:"events"`
+		CreatedAt      int64    `json:"created_at"`
+		UpdatedAt      int64    `json:"updated_at"`
+		SingleFileName *string  `json:"single_file_name"`
+	} `json:"installation"`
+	Repositories []struct {
+		ID       int64  `json:"id"`
+		Name     string `json:"name"`
+		FullName string `json:"full_name"`
+	} `json:"repositories"`
+	Sender struct {
+		Login             string `json:"login"`
+		ID                int64  `json:"id"`
+		AvatarURL         string `json:"avatar_url"`
+		GravatarID        string `json:"gravatar_id"`
+		URL               string `json:"url"`
+		HTMLURL           string `json:"html_url"`
+		FollowersURL      string `json:"followers_url"`
+		FollowingURL      string `json:"following_url"`
+		GistsURL          string `json:"gists_url"`
+		StarredURL        string `json:"starred_url"`
+		SubscriptionsURL  string `json:"subscriptions_url"`
+		OrganizationsURL  string `json:"organizations_url"`
+		ReposURL          string `json:"repos_url"`
+		EventsURL         string `json:"events_url"`
+		ReceivedEventsURL string `json:"received_events_url"`
+		Type              string `json:"type"`
+		SiteAdmin         bool   `json:"site_admin"`
+	} `json:"sender"`
+}
+
+// InstallationRepositoriesPayload contains the information for GitHub's installation_repositories hook event
+type InstallationRepositoriesPayload struct {
+	Action       string `json:"action"`
+	Installation struct {
+		ID      int64 `json:"id"`
+		Account struct {
+			Login             string `json:"login"`
+			ID                int64  `json:"id"`
+			AvatarURL         string `json:"avatar_url"`
+			GravatarID        string `json:"gravatar_id"`
+			URL               string `json:"url"`
+			HTMLURL           string `json:"html_url"`
+			FollowersURL      string `json:"followers_url"`
+			FollowingURL      string `json:"following_url"`
+			GistsURL          string `json:"gists_url"`
+			StarredURL        string `json:"starred_url"`
+			SubscriptionsURL  string `json:"subscriptions_url"`
+			OrganizationsURL  string `json:"organizations_url"`
+			ReposURL          string `json:"repos_url"`
+			EventsURL         string `json:"events_url"`
+			ReceivedEventsURL string `json:"received_events_url"`
+			Type              string `json:"type"`
+			SiteAdmin         bool   `json:"site_admin"`
+		} `json:"account"`
+		RepositorySelection string `json:"repository_selection"`
+		AccessTokensURL     string `json:"access_tokens_url"`
+		RepositoriesURL     string `json:"repositories_url"`
+		HTMLURL             string `json:"html_url"`
+		AppID               int    `json:"app_id"`
+		TargetID            int    `json:"target_id"`
+		TargetType          string `json:"target_type"`
+		Permissions         struct {
+			Issues             string `json:"issues"`
+			Metadata           string `json:"metadata"`
+			PullRequests       string `json:"pull_requests"`
+			RepositoryProjects string `json:"repository_projects"`
+		} `json:"permissions"`
+		Events         []string `json:"events"`
+		CreatedAt      int64    `json:"created_at"`
+		UpdatedAt      int64    `json:"updated_at"`
+		SingleFileName *string  `json:"single_file_name"`
+	} `json:"installation"`
+	RepositorySelection string `json:"repository_selection"`
+	RepositoriesAdded   []struct {
+		ID       int64  `json:"id"`
+		Name     string `json:"name"`
+		FullName string `json:"full_name"`
+		Private  bool   `json:"private"`
+	} `json:"repositories_added"`
+	RepositoriesRemoved []struct {
+		ID       int64  `json:"id"`
+		Name     string `json:"name"`
+		FullName string `json:"full_name"`
+		Private  bool   `json:"private"`
+	} `json:"repositories_removed"`
+	// Requester is the user that requested the repositories be added, when an organization owner had to approve it
+	Requester *struct {
+		Login             string `json:"login"`
+		ID                int64  `json:"id"`
+		AvatarURL         string `json:"avatar_url"`
+		GravatarID        string `json:"gravatar_id"`
+		URL               string `json:"url"`
+		HTMLURL           string `json:"html_url"`
+		FollowersURL      string `json:"followers_url"`
+		FollowingURL      string `json:"following_url"`
+		GistsURL          string `json:"gists_url"`
+		StarredURL        string `json:"starred_url"`
+		SubscriptionsURL  string `json:"subscriptions_url"`
+		OrganizationsURL  string `json:"organizations_url"`
+		ReposURL          string `json:"repos_url"`
+		EventsURL         string `json:"events_url"`
+		ReceivedEventsURL string `json:"received_events_url"`
+		Type              string `json:"type"`
+		SiteAdmin         bool   `json:"site_admin"`
+	} `json:"requester"`
+	Sender struct {
+		Login             string `json:"login"`
+		ID                int64  `json:"id"`
+		AvatarURL         string `json:"avatar_url"`
+		GravatarID        string `json:"gravatar_id"`
+		URL               string `json:"url"`
+		HTMLURL           string `json:"html_url"`
+		FollowersURL      string `json:"followers_url"`
+		FollowingURL      string `json:"following_url"`
+		GistsURL          string `json:"gists_url"`
+		StarredURL        string `json:"starred_url"`
+		SubscriptionsURL  string `json:"subscriptions_url"`
+		OrganizationsURL  string `json:"organizations_url"`
+		ReposURL          string `json:"repos_url"`
+		EventsURL         string `json:"events_url"`
+		ReceivedEventsURL string `json:"received_events_url"`
+		Type              string `json:"type"`
+		SiteAdmin         bool   `json:"site_admin"`
+	} `json:"sender"`
+}
+
+// IssueCommentPayload contains the information for GitHub's issue_comment hook event
+type IssueCommentPayload struct {
+	Action string `json:"action"`
+	Issue  struct {
+		URL         string `json:"url"`
+		LabelsURL   string `json:"labels_url"`
+		CommentsURL string `json:"comments_url"`
+		EventsURL   string `json:"events_url"`
+		HTMLURL     string `json:"html_url"`
+		ID          int64  `json:"id"`
+		Number      int64  `json:"number"`
+		Title       string `json:"title"`
+		User        struct {
+			Login             string `json:"login"`
+			ID                int64  `json:"id"`
+			AvatarURL         string `json:"avatar_url"`
+			GravatarID        string `json:"gravatar_id"`
+			URL               string `json:"url"`
+			HTMLURL           string `json:"html_url"`
+			FollowersURL      string `json:"followers_url"`
+			FollowingURL      string `json:"following_url"`
+			GistsURL          string `json:"gists_url"`
+			StarredURL        string `json:"starred_url"`
+			SubscriptionsURL  string `json:"subscriptions_url"`
+			OrganizationsURL  string `json:"organizations_url"`
+			ReposURL          string `json:"repos_url"`
+			EventsURL         string `json:"events_url"`
+			ReceivedEventsURL string `json:"received_events_url"`
+			Type              string `json:"type"`
+			SiteAdmin         bool   `json:"site_admin"`
+		} `json:"user"`
+		Labels []struct {
+			URL   string `json:"url"`
+			Name  string `json:"name"`
+			Color string `json:"color"`
+		} `json:"labels"`
+		State     string     `json:"state"`
+		Locked    bool       `json:"locked"`
+		Assignee  *Assignee  `json:"assignee"`
+		Milestone *Milestone `json:"milestone"`
+		Comments  int64      `json:"comments"`
+		CreatedAt time.Time  `json:"created_at"`
+		UpdatedAt time.Time  `json:"updated_at"`
+		ClosedAt  *time.Time `json:"closed_at"`
+		Body      string     `json:"body"`
+		// PullRequest is only set when the issue is a pull request
+		PullRequest *struct {
+			URL      string     `json:"url"`
+			HTMLURL  string     `json:"html_url"`
+			DiffURL  string     `json:"diff_url"`
+			PatchURL string     `json:"patch_url"`
+			MergedAt *time.Time `json:"merged_at"`
+		} `json:"pull_request,omitempty"`
+	} `json:"issue"`
+	Comment struct {
+		URL      string `json:"url"`
+		HTMLURL  string `json:"html_url"`
+		IssueURL string `json:"issue_url"`
+		ID       int64  `json:"id"`
+		User     struct {
+			Login             string `json:"login"`
+			ID                int64  `json:"id"`
+			AvatarURL         string `json:"avatar_url"`
+			GravatarID        string `json:"gravatar_id"`
+			URL               string `json:"url"`
+			HTMLURL           string `json:"html_url"`
+			FollowersURL      string `json:"followers_url"`
+			FollowingURL      string `json:"following_url"`
+			GistsURL          string `json:"gists_url"`
+			StarredURL        string `json:"starred_url"`
+			SubscriptionsURL  string `json:"subscriptions_url"`
+			OrganizationsURL  string `json:"organizations_url"`
+			ReposURL          string `json:"repos_url"`
+			EventsURL         string `json:"events_url"`
+			ReceivedEventsURL string `json:"received_events_url"`
+			Type              string `json:"type"`
+			SiteAdmin         bool   `json:"site_admin"`
+		} `json:"user"`
+		CreatedAt         time.Time `json:"created_at"`
+		UpdatedAt         time.Time `json:"updated_at"`
+		Body              string    `json:"body"`
+		AuthorAssociation string    `json:"author_association"`
+	} `json:"comment"`
 	Repository struct {
 		ID       int64  `json:"id"`
 		Name     string `json:"name"`
@@ -2302,16 +2605,6 @@ type ProjectCardPayload struct {
 		Watchers         int64     `json:"watchers"`
 		DefaultBranch    string    `json:"default_branch"`
 	} `json:"repository"`
-	Organization struct {
-		Login            string `json:"login"`
-		ID               int64  `json:"id"`
-		URL              string `json:"url"`
-		ReposURL         string `json:"repos_url"`
-		EventsURL        string `json:"events_url"`
-		MembersURL       string `json:"members_url"`
-		PublicMembersURL string `json:"public_members_url"`
-		AvatarURL        string `json:"avatar_url"`
-	} `json:"organization"`
 	Sender struct {
 		Login             string `json:"login"`
 		ID                int64  `json:"id"`
@@ -2331,35 +2624,94 @@ type ProjectCardPayload struct {
 		Type              string `json:"type"`
 		SiteAdmin         bool   `json:"site_admin"`
 	} `json:"sender"`
+	Installation Installation `json:"installation"`
 }
 
-// ProjectColumnPayload contains the information for GitHub's project_column hook event
-type ProjectColumnPayload struct {
-	Action        string `json:"action"`
-	ProjectColumn struct {
-		URL        string `json:"url"`
-		ProjectURL string `json:"project_url"`
-		CardsURL   string `json:"cards_url"`
-		ID         int64  `json:"id"`
-		Name       string `json:"name"`
-		CreatedAt  int64  `json:"created_at"`
-		UpdatedAt  int64  `json:"updated_at"`
-	} `json:"project_column"`
-	Repository struct {
-		ID       int64  `json:"id"`
-		Name     string `json:"name"`
-		FullName string `json:"full_name"`
-		Owner    struct {
-			Login             string `json:"login"`
-			ID                int64  `json:"id"`
-			AvatarURL         string `json:"avatar_url"`
-			GravatarID        string `json:"gravatar_id"`
-			URL               string `json:"url"`
-			HTMLURL           string `json:"html_url"`
-			FollowersURL      string `json:"followers_url"`
-			FollowingURL      string `json:"following_url"`
-			GistsURL          string `json:"gists_url"`
-			StarredURL        string `json:"starred_url"`
+// IsPullRequest returns whether the comment was made on a pull request rather than an issue
+func (p IssueCommentPayload) IsPullRequest() bool {
+	return p.Issue.PullRequest != nil
+}
+
+// IssuesPayload contains the information for GitHub's issues hook event
+type IssuesPayload struct {
+	Action string `json:"action"`
+	Issue  struct {
+		URL         string `json:"url"`
+		LabelsURL   string `json:"labels_url"`
+		CommentsURL string `json:"comments_url"`
+		EventsURL   string `json:"events_url"`
+		HTMLURL     string `json:"html_url"`
+		ID          int64  `json:"id"`
+		Number      int64  `json:"number"`
+		Title       string `json:"title"`
+		User        struct {
+			Login             string `json:"login"`
+			ID                int64  `json:"id"`
+			AvatarURL         string `json:"avatar_url"`
+			GravatarID        string `json:"gravatar_id"`
+			URL               string `json:"url"`
+			HTMLURL           string `json:"html_url"`
+			FollowersURL      string `json:"followers_url"`
+			FollowingURL      string `json:"following_url"`
+			GistsURL          string `json:"gists_url"`
+			StarredURL        string `json:"starred_url"`
+			SubscriptionsURL  string `json:"subscriptions_url"`
+			OrganizationsURL  string `json:"organizations_url"`
+			ReposURL          string `json:"repos_url"`
+			EventsURL         string `json:"events_url"`
+			ReceivedEventsURL string `json:"received_events_url"`
+			Type              string `json:"type"`
+			SiteAdmin         bool   `json:"site_admin"`
+		} `json:"user"`
+		Labels []struct {
+			ID      int64  `json:"id"`
+			URL     string `json:"url"`
+			Name    string `json:"name"`
+			Color   string `json:"color"`
+			Default bool   `json:"default"`
+		} `json:"labels"`
+		State            string     `json:"state"`
+		Locked           bool       `json:"locked"`
+		ActiveLockReason *string    `json:"active_lock_reason"`
+		Assignee         *Assignee  `json:"assignee"`
+		Assignees        []Assignee `json:"assignees"`
+		Milestone        *Milestone `json:"milestone"`
+		Comments         int64      `json:"comments"`
+		CreatedAt        time.Time  `json:"created_at"`
+		UpdatedAt        time.Time  `json:"updated_at"`
+		ClosedAt         *time.Time `json:"closed_at"`
+		Body             string     `json:"body"`
+	} `json:"issue"`
+	// Assignee is the user assigned or unassigned by the assigned and unassigned actions
+	Assignee *Assignee `json:"assignee,omitempty"`
+	// Label is the label added or removed by the labeled and unlabeled actions
+	Label *struct {
+		ID          int64  `json:"id"`
+		URL         string `json:"url"`
+		Name        string `json:"name"`
+		Color       string `json:"color"`
+		Default     bool   `json:"default"`
+		Description string `json:"description"`
+	} `json:"label,omitempty"`
+	// Changes holds the previous values of the fields the edited action changed, along with the
+	// issue and repository an issue was moved to for the transferred action and the ones it was moved
+	// from for the opened action sent to the repository it was transferred to
+	Changes    *IssueChanges `json:"changes,omitempty"`
+	Repository struct {
+		ID       int64  `json:"id"`
+		Name     string `json:"name"`
+		FullName string `json:"full_name"`
+		Owner    struct {
+			Login             string `json:"login"`
+			ID                int64  `json:"id"`
+			AvatarURL         string `json:"avatar_url"`
+			GravatarID        string `json:"gravatar_id"`
+			URL               string `json:"url"`
+			HTMLURL           string `json:"html_url"`
+			FollowersURL      string `json:"followers_url"`
+			FollowingURL      string `json:"following_url"`
+			GistsURL          string `json:"gists_url"`
+			StarredURL        string `json:"starred_url"`
 			SubscriptionsURL  string `json:"subscriptions_url"`
 			OrganizationsURL  string `json:"organizations_url"`
 			ReposURL          string `json:"repos_url"`
@@ -2432,16 +2784,6 @@ type ProjectColumnPayload struct {
 		Watchers         int64     `json:"watchers"`
 		DefaultBranch    string    `json:"default_branch"`
 	} `json:"repository"`
-	Organization struct {
-		Login            string `json:"login"`
-		ID               int64  `json:"id"`
-		URL              string `json:"url"`
-		ReposURL         string `json:"repos_url"`
-		EventsURL        string `json:"events_url"`
-		MembersURL       string `json:"members_url"`
-		PublicMembersURL string `json:"public_members_url"`
-		AvatarURL        string `json:"avatar_url"`
-	} `json:"organization"`
 	Sender struct {
 		Login             string `json:"login"`
 		ID                int64  `json:"id"`
@@ -2461,42 +2803,19 @@ type ProjectColumnPayload struct {
 		Type              string `json:"type"`
 		SiteAdmin         bool   `json:"site_admin"`
 	} `json:"sender"`
+	Installation Installation `json:"installation"`
 }
 
-// ProjectPayload contains the information for GitHub's project hook event
-type ProjectPayload struct {
-	Action  string `json:"action"`
-	Project struct {
-		OwnerURL   string `json:"owner_url"`
-		URL        string `json:"url"`
-		ColumnsURL string `json:"columns_url"`
-		ID         int64  `json:"id"`
-		Name       string `json:"name"`
-		Body       string `json:"body"`
-		Number     int64  `json:"number"`
-		State      string `json:"state"`
-		Creator    struct {
-			Login             string `json:"login"`
-			ID                int64  `json:"id"`
-			AvatarURL         string `json:"avatar_url"`
-			GravatarID        string `json:"gravatar_id"`
-			URL               string `json:"url"`
-			HTMLURL           string `json:"html_url"`
-			FollowersURL      string `json:"followers_url"`
-			FollowingURL      string `json:"following_url"`
-			GistsURL          string `json:"gists_url"`
-			StarredURL        string `json:"starred_url"`
-			SubscriptionsURL  string `json:"subscriptions_url"`
-			OrganizationsURL  string `json:"organizations_url"`
-			ReposURL          string `json:"repos_url"`
-			EventsURL         string `json:"events_url"`
-			ReceivedEventsURL string `json:"received_events_url"`
-			Type              string `json:"type"`
-			SiteAdmin         bool   `json:"site_admin"`
-		} `json:"creator"`
-		CreatedAt int64 `json:"created_at"`
-		UpdatedAt int64 `json:"updated_at"`
-	} `json:"project"`
+// LabelPayload contains the information for GitHub's label hook event
+type LabelPayload struct {
+	Action string `json:"action"`
+	Label  struct {
+		URL   string `json:"url"`
+		Name  string `json:"name"`
+		Color string `json:"color"`
+	} `json:"label"`
+	// Changes holds the previous values of the fields the edited action changed
+	Changes    *Changes `json:"changes,omitempty"`
 	Repository struct {
 		ID       int64  `json:"id"`
 		Name     string `json:"name"`
@@ -2522,7 +2841,7 @@ type ProjectPayload struct {
 		} `json:"owner"`
 		Private          bool      `json:"private"`
 		HTMLURL          string    `json:"html_url"`
-		Description      string    `json:"description"`
+		Description      *string   `json:"description"`
 		Fork             bool      `json:"fork"`
 		URL              string    `json:"url"`
 		ForksURL         string    `json:"forks_url"`
@@ -2560,6 +2879,7 @@ type ProjectPayload struct {
 		NotificationsURL string    `json:"notifications_url"`
 		LabelsURL        string    `json:"labels_url"`
 		ReleasesURL      string    `json:"releases_url"`
+		DeploymentsURL   string    `json:"deployments_url"`
 		CreatedAt        time.Time `json:"created_at"`
 		UpdatedAt        time.Time `json:"updated_at"`
 		PushedAt         time.Time `json:"pushed_at"`
@@ -2590,9 +2910,12 @@ type ProjectPayload struct {
 		URL              string `json:"url"`
 		ReposURL         string `json:"repos_url"`
 		EventsURL        string `json:"events_url"`
+		HooksURL         string `json:"hooks_url"`
+		IssuesURL        string `json:"issues_url"`
 		MembersURL       string `json:"members_url"`
 		PublicMembersURL string `json:"public_members_url"`
 		AvatarURL        string `json:"avatar_url"`
+		Description      string `json:"description"`
 	} `json:"organization"`
 	Sender struct {
 		Login             string `json:"login"`
@@ -2613,10 +2936,31 @@ type ProjectPayload struct {
 		Type              string `json:"type"`
 		SiteAdmin         bool   `json:"site_admin"`
 	} `json:"sender"`
+	Installation Installation `json:"installation"`
 }
 
-// PublicPayload contains the information for GitHub's public hook event
-type PublicPayload struct {
+// MemberPayload contains the information for GitHub's member hook event
+type MemberPayload struct {
+	Action string `json:"action"`
+	Member struct {
+		Login             string `json:"login"`
+		ID                int64  `json:"id"`
+		AvatarURL         string `json:"avatar_url"`
+		GravatarID        string `json:"gravatar_id"`
+		URL               string `json:"url"`
+		HTMLURL           string `json:"html_url"`
+		FollowersURL      string `json:"followers_url"`
+		FollowingURL      string `json:"following_url"`
+		GistsURL          string `json:"gists_url"`
+		StarredURL        string `json:"starred_url"`
+		SubscriptionsURL  string `json:"subscriptions_url"`
+		OrganizationsURL  string `json:"organizations_url"`
+		ReposURL          string `json:"repos_url"`
+		EventsURL         string `json:"events_url"`
+		ReceivedEventsURL string `json:"received_events_url"`
+		Type              string `json:"type"`
+		SiteAdmin         bool   `json:"site_admin"`
+	} `json:"member"`
 	Repository struct {
 		ID       int64  `json:"id"`
 		Name     string `json:"name"`
@@ -2723,24 +3067,103 @@ type PublicPayload struct {
 		Type              string `json:"type"`
 		SiteAdmin         bool   `json:"site_admin"`
 	} `json:"sender"`
+	Installation Installation `json:"installation"`
 }
 
-// PullRequestPayload contains the information for GitHub's pull_request hook event
-type PullRequestPayload struct {
-	Action      string `json:"action"`
-	Number      int64  `json:"number"`
-	PullRequest struct {
-		URL      string `json:"url"`
+// MembershipPayload contains the information for GitHub's membership hook event
+type MembershipPayload struct {
+	Action string `json:"action"`
+	Scope  string `json:"scope"`
+	Member struct {
+		Login             string `json:"login"`
+		ID                int64  `json:"id"`
+		AvatarURL         string `json:"avatar_url"`
+		GravatarID        string `json:"gravatar_id"`
+		URL               string `json:"url"`
+		HTMLURL           string `json:"html_url"`
+		FollowersURL      string `json:"followers_url"`
+		FollowingURL      string `json:"following_url"`
+		GistsURL          string `json:"gists_url"`
+		StarredURL        string `json:"starred_url"`
+		SubscriptionsURL  string `json:"subscriptions_url"`
+		OrganizationsURL  string `json:"organizations_url"`
+		ReposURL          string `json:"repos_url"`
+		EventsURL         string `json:"events_url"`
+		ReceivedEventsURL string `json:"received_events_url"`
+		Type              string `json:"type"`
+		SiteAdmin         bool   `json:"site_admin"`
+	} `json:"member"`
+	Sender struct {
+		Login             string `json:"login"`
+		ID                int64  `json:"id"`
+		AvatarURL         string `json:"avatar_url"`
+		GravatarID        string `json:"gravatar_id"`
+		URL               string `json:"url"`
+		HTMLURL           string `json:"html_url"`
+		FollowersURL      string `json:"followers_url"`
+		FollowingURL      string `json:"following_url"`
+		GistsURL          string `json:"gists_url"`
+		StarredURL        string `json:"starred_url"`
+		SubscriptionsURL  string `json:"subscriptions_url"`
+		OrganizationsURL  string `json:"organizations_url"`
+		ReposURL          string `json:"repos_url"`
+		EventsURL         string `json:"events_url"`
+		ReceivedEventsURL string `json:"received_events_url"`
+		Type              string `json:"type"`
+		SiteAdmin         bool   `json:"site_admin"`
+	} `json:"sender"`
+	Team struct {
+		Name            string `json:"name"`
+		ID              int64  `json:"id"`
+		Slug            string `json:"slug"`
+		Permission      string `json:"permission"`
+		URL             string `json:"url"`
+		MembersURL      string `json:"members_url"`
+		RepositoriesURL string `json:"repositories_url"`
+	} `json:"team"`
+	Organization struct {
+		Login            string `json:"login"`
+		ID               int64  `json:"id"`
+		URL              string `json:"url"`
+		ReposURL         string `json:"repos_url"`
+		EventsURL        string `json:"events_url"`
+		MembersURL       string `json:"members_url"`
+		PublicMembersURL string `json:"public_members_url"`
+		AvatarURL        string `json:"avatar_url"`
+	} `json:"organization"`
+	Installation Installation `json:"installation"`
+}
+
+// MergeGroupPayload contains the information for GitHub's merge_group hook event
+type MergeGroupPayload struct {
+	Action string `json:"action"`
+	// Reason is set when the merge group is destroyed, such as merged, invalidated or dequeued
+	Reason     string `json:"reason,omitempty"`
+	MergeGroup struct {
+		HeadSha    string `json:"head_sha"`
+		HeadRef    string `json:"head_ref"`
+		BaseSha    string `json:"base_sha"`
+		BaseRef    string `json:"base_ref"`
+		HeadCommit struct {
+			ID        string    `json:"id"`
+			TreeID    string    `json:"tree_id"`
+			Message   string    `json:"message"`
+			Timestamp time.Time `json:"timestamp"`
+			Author    struct {
+				Name  string `json:"name"`
+				Email string `json:"email"`
+			} `json:"author"`
+			Committer struct {
+				Name  string `json:"name"`
+				Email string `json:"email"`
+			} `json:"committer"`
+		} `json:"head_commit"`
+	} `json:"merge_group"`
+	Repository struct {
 		ID       int64  `json:"id"`
-		HTMLURL  string `json:"html_url"`
-		DiffURL  string `json:"diff_url"`
-		PatchURL string `json:"patch_url"`
-		IssueURL string `json:"issue_url"`
-		Number   int64  `json:"number"`
-		State    string `json:"state"`
-		Locked   bool   `json:"locked"`
-		Title    string `json:"title"`
-		User     struct {
+		Name     string `json:"name"`
+		FullName string `json:"full_name"`
+		Owner    struct {
 			Login             string `json:"login"`
 			ID                int64  `json:"id"`
 			AvatarURL         string `json:"avatar_url"`
@@ -2758,305 +3181,133 @@ type PullRequestPayload struct {
 			ReceivedEventsURL string `json:"received_events_url"`
 			Type              string `json:"type"`
 			SiteAdmin         bool   `json:"site_admin"`
-		} `json:"user"`
-		Body               string     `json:"body"`
-		CreatedAt          time.Time  `json:"created_at"`
-		UpdatedAt          time.Time  `json:"updated_at"`
-		ClosedAt           *time.Time `json:"closed_at"`
-		MergedAt           *time.Time `json:"merged_at"`
-		MergeCommitSha     *string    `json:"merge_commit_sha"`
-		Assignee           *Assignee  `json:"assignee"`
-		Milestone          *Milestone `json:"milestone"`
-		CommitsURL         string     `json:"commits_url"`
-		ReviewCommentsURL  string     `json:"review_comments_url"`
-		ReviewCommentURL   string     `json:"review_comment_url"`
-		CommentsURL        string     `json:"comments_url"`
-		StatusesURL        string     `json:"statuses_url"`
-		RequestedReviewers []struct {
-			Login             string `json:"login"`
-			ID                int    `json:"id"`
-			AvatarURL         string `json:"avatar_url"`
-			GravatarID        string `json:"gravatar_id"`
-			URL               string `json:"url"`
-			HTMLURL           string `json:"html_url"`
-			FollowersURL      string `json:"followers_url"`
-			FollowingURL      string `json:"following_url"`
-			GistsURL          string `json:"gists_url"`
-			StarredURL        string `json:"starred_url"`
-			SubscriptionsURL  string `json:"subscriptions_url"`
-			OrganizationsURL  string `json:"organizations_url"`
-			ReposURL          string `json:"repos_url"`
-			EventsURL         string `json:"events_url"`
-			ReceivedEventsURL string `json:"received_events_url"`
+		} `json:"owner"`
+		Private          bool      `json:"private"`
+		HTMLURL          string    `json:"html_url"`
+		Description      string    `json:"description"`
+		Fork             bool      `json:"fork"`
+		URL              string    `json:"url"`
+		ForksURL         string    `json:"forks_url"`
+		KeysURL          string    `json:"keys_url"`
+		CollaboratorsURL string    `json:"collaborators_url"`
+		TeamsURL         string    `json:"teams_url"`
+		HooksURL         string    `json:"hooks_url"`
+		IssueEventsURL   string    `json:"issue_events_url"`
+		EventsURL        string    `json:"events_url"`
+		AssigneesURL     string    `json:"assignees_url"`
+		BranchesURL      string    `json:"branches_url"`
+		TagsURL          string    `json:"tags_url"`
+		BlobsURL         string    `json:"blobs_url"`
+		GitTagsURL       string    `json:"git_tags_url"`
+		GitRefsURL       string    `json:"git_refs_url"`
+		TreesURL         string    `json:"trees_url"`
+		StatusesURL      string    `json:"statuses_url"`
+		LanguagesURL     string    `json:"languages_url"`
+		StargazersURL    string    `json:"stargazers_url"`
+		ContributorsURL  string    `json:"contributors_url"`
+		SubscribersURL   string    `json:"subscribers_url"`
+		SubscriptionURL  string    `json:"subscription_url"`
+		CommitsURL       string    `json:"commits_url"`
+		GitCommitsURL    string    `json:"git_commits_url"`
+		CommentsURL      string    `json:"comments_url"`
+		IssueCommentURL  string    `json:"issue_comment_url"`
+		ContentsURL      string    `json:"contents_url"`
+		CompareURL       string    `json:"compare_url"`
+		MergesURL        string    `json:"merges_url"`
+		ArchiveURL       string    `json:"archive_url"`
+		DownloadsURL     string    `json:"downloads_url"`
+		IssuesURL        string    `json:"issues_url"`
+		PullsURL         string    `json:"pulls_url"`
+		MilestonesURL    string    `json:"milestones_url"`
+		NotificationsURL string    `json:"notifications_url"`
+		LabelsURL        string    `json:"labels_url"`
+		ReleasesURL      string    `json:"releases_url"`
+		CreatedAt        time.Time `json:"created_at"`
+		UpdatedAt        time.Time `json:"updated_at"`
+		PushedAt         time.Time `json:"pushed_at"`
+		GitURL           string    `json:"git_url"`
+		SSHURL           string    `json:"ssh_url"`
+		CloneURL         string    `json:"clone_url"`
+		SvnURL           string    `json:"svn_url"`
+		Homepage         *string   `json:"homepage"`
+		Size             int64     `json:"size"`
+		StargazersCount  int64     `json:"stargazers_count"`
+		WatchersCount    int64     `json:"watchers_count"`
+		Language         *string   `json:"language"`
+		HasIssues        bool      `json:"has_issues"`
+		HasDownloads     bool      `json:"has_downloads"`
+		HasWiki          bool      `json:"has_wiki"`
+		HasPages         bool      `json:"has_pages"`
+		ForksCount       int64     `json:"forks_count"`
+		MirrorURL        *string   `json:"mirror_url"`
+		OpenIssuesCount  int64     `json:"open_issues_count"`
+		Forks            int64     `json:"forks"`
+		OpenIssues       int64     `json:"open_issues"`
+		Watchers         int64     `json:"watchers"`
+		DefaultBranch    string    `json:"default_branch"`
+	} `json:"repository"`
+	Sender struct {
+		Login             string `json:"login"`
+		ID                int64  `json:"id"`
+		AvatarURL         string `json:"avatar_url"`
+		GravatarID        string `json:"gravatar_id"`
+		URL               string `json:"url"`
+		HTMLURL           string `json:"html_url"`
+		FollowersURL      string `json:"followers_url"`
+		FollowingURL      string `json:"following_url"`
+		GistsURL          string `json:"gists_url"`
+		StarredURL        string `json:"starred_url"`
+		SubscriptionsURL  string `json:"subscriptions_url"`
+		OrganizationsURL  string `json:"organizations_url"`
+		ReposURL          string `json:"repos_url"`
+		EventsURL         string `json:"events_url"`
+		ReceivedEventsURL string `json:"received_events_url"`
+		Type              string `json:"type"`
+		SiteAdmin         bool   `json:"site_admin"`
+	} `json:"sender"`
+	Installation Installation `json:"installation"`
+}
+
+// MilestonePayload contains the information for GitHub's milestone hook event
+type MilestonePayload struct {
+	Action    string `json:"action"`
+	Milestone struct {
+		URL         string  `json:"url"`
+		HTMLURL     string  `json:"html_url"`
+		LabelsURL   string  `json:"labels_url"`
+		ID          int64   `json:"id"`
+		Number      int64   `json:"number"`
+		Title       string  `json:"title"`
+		Description *string `json:"description"`
+		Creator     struct {
+			Login             string `json:"login"`
+			ID                int64  `json:"id"`
+			AvatarURL         string `json:"avatar_url"`
+			GravatarID        string `json:"gravatar_id"`
+			URL               string `json:"url"`
+			HTMLURL           string `json:"html_url"`
+			FollowersURL      string `json:"followers_url"`
+			FollowingURL      string `json:"following_url"`
+			GistsURL          string `json:"gists_url"`
+			StarredURL        string `json:"starred_url"`
+			SubscriptionsURL  string `json:"subscriptions_url"`
+			OrganizationsURL  string `json:"organizations_url"`
+			ReposURL          string `json:"repos_url"`
+			EventsURL         string `json:"events_url"`
+			ReceivedEventsURL string `json:"received_events_url"`
 			Type              string `json:"type"`
 			SiteAdmin         bool   `json:"site_admin"`
-		} `json:"requested_reviewers,omitempty"`
-		Head struct {
-			Label string `json:"label"`
-			Ref   string `json:"ref"`
-			Sha   string `json:"sha"`
-			User  struct {
-				Login             string `json:"login"`
-				ID                int64  `json:"id"`
-				AvatarURL         string `json:"avatar_url"`
-				GravatarID        string `json:"gravatar_id"`
-				URL               string `json:"url"`
-				HTMLURL           string `json:"html_url"`
-				FollowersURL      string `json:"followers_url"`
-				FollowingURL      string `json:"following_url"`
-				GistsURL          string `json:"gists_url"`
-				StarredURL        string `json:"starred_url"`
-				SubscriptionsURL  string `json:"subscriptions_url"`
-				OrganizationsURL  string `json:"organizations_url"`
-				ReposURL          string `json:"repos_url"`
-				EventsURL         string `json:"events_url"`
-				ReceivedEventsURL string `json:"received_events_url"`
-				Type              string `json:"type"`
-				SiteAdmin         bool   `json:"site_admin"`
-			} `json:"user"`
-			Repo struct {
-				ID       int64  `json:"id"`
-				Name     string `json:"name"`
-				FullName string `json:"full_name"`
-				Owner    struct {
-					Login             string `json:"login"`
-					ID                int64  `json:"id"`
-					AvatarURL         string `json:"avatar_url"`
-					GravatarID        string `json:"gravatar_id"`
-					URL               string `json:"url"`
-					HTMLURL           string `json:"html_url"`
-					FollowersURL      string `json:"followers_url"`
-					FollowingURL      string `json:"following_url"`
-					GistsURL          string `json:"gists_url"`
-					StarredURL        string `json:"starred_url"`
-					SubscriptionsURL  string `json:"subscriptions_url"`
-					OrganizationsURL  string `json:"organizations_url"`
-					ReposURL          string `json:"repos_url"`
-					EventsURL         string `json:"events_url"`
-					ReceivedEventsURL string `json:"received_events_url"`
-					Type              string `json:"type"`
-					SiteAdmin         bool   `json:"site_admin"`
-				} `json:"owner"`
-				Private          bool      `json:"private"`
-				HTMLURL          string    `json:"html_url"`
-				Description      string    `json:"description"`
-				Fork             bool      `json:"fork"`
-				URL              string    `json:"url"`
-				ForksURL         string    `json:"forks_url"`
-				KeysURL          string    `json:"keys_url"`
-				CollaboratorsURL string    `json:"collaborators_url"`
-				TeamsURL         string    `json:"teams_url"`
-				HooksURL         string    `json:"hooks_url"`
-				IssueEventsURL   string    `json:"issue_events_url"`
-				EventsURL        string    `json:"events_url"`
-				AssigneesURL     string    `json:"assignees_url"`
-				BranchesURL      string    `json:"branches_url"`
-				TagsURL          string    `json:"tags_url"`
-				BlobsURL         string    `json:"blobs_url"`
-				GitTagsURL       string    `json:"git_tags_url"`
-				GitRefsURL       string    `json:"git_refs_url"`
-				TreesURL         string    `json:"trees_url"`
-				StatusesURL      string    `json:"statuses_url"`
-				LanguagesURL     string    `json:"languages_url"`
-				StargazersURL    string    `json:"stargazers_url"`
-				ContributorsURL  string    `json:"contributors_url"`
-				SubscribersURL   string    `json:"subscribers_url"`
-				SubscriptionURL  string    `json:"subscription_url"`
-				CommitsURL       string    `json:"commits_url"`
-				GitCommitsURL    string    `json:"git_commits_url"`
-				CommentsURL      string    `json:"comments_url"`
-				IssueCommentURL  string    `json:"issue_comment_url"`
-				ContentsURL      string    `json:"contents_url"`
-				CompareURL       string    `json:"compare_url"`
-				MergesURL        string    `json:"merges_url"`
-				ArchiveURL       string    `json:"archive_url"`
-				DownloadsURL     string    `json:"downloads_url"`
-				IssuesURL        string    `json:"issues_url"`
-				PullsURL         string    `json:"pulls_url"`
-				MilestonesURL    string    `json:"milestones_url"`
-				NotificationsURL string    `json:"notifications_url"`
-				LabelsURL        string    `json:"labels_url"`
-				ReleasesURL      string    `json:"releases_url"`
-				CreatedAt        time.Time `json:"created_at"`
-				UpdatedAt        time.Time `json:"updated_at"`
-				PushedAt         time.Time `json:"pushed_at"`
-				GitURL           string    `json:"git_url"`
-				SSHURL           string    `json:"ssh_url"`
-				CloneURL         string    `json:"clone_url"`
-				SvnURL           string    `json:"svn_url"`
-				Homepage         *string   `json:"homepage"`
-				Size             int64     `json:"size"`
-				StargazersCount  int64     `json:"stargazers_count"`
-				WatchersCount    int64     `json:"watchers_count"`
-				Language         *string   `json:"language"`
-				HasIssues        bool      `json:"has_issues"`
-				HasDownloads     bool      `json:"has_downloads"`
-				HasWiki          bool      `json:"has_wiki"`
-				HasPages         bool      `json:"has_pages"`
-				ForksCount       int64     `json:"forks_count"`
-				MirrorURL        *string   `json:"mirror_url"`
-				OpenIssuesCount  int64     `json:"open_issues_count"`
-				Forks            int64     `json:"forks"`
-				OpenIssues       int64     `json:"open_issues"`
-				Watchers         int64     `json:"watchers"`
-				DefaultBranch    string    `json:"default_branch"`
-			} `json:"repo"`
-		} `json:"head"`
-		Base struct {
-			Label string `json:"label"`
-			Ref   string `json:"ref"`
-			Sha   string `json:"sha"`
-			User  struct {
-				Login             string `json:"login"`
-				ID                int64  `json:"id"`
-				AvatarURL         string `json:"avatar_url"`
-				GravatarID        string `json:"gravatar_id"`
-				URL               string `json:"url"`
-				HTMLURL           string `json:"html_url"`
-				FollowersURL      string `json:"followers_url"`
-				FollowingURL      string `json:"following_url"`
-				GistsURL          string `json:"gists_url"`
-				StarredURL        string `json:"starred_url"`
-				SubscriptionsURL  string `json:"subscriptions_url"`
-				OrganizationsURL  string `json:"organizations_url"`
-				ReposURL          string `json:"repos_url"`
-				EventsURL         string `json:"events_url"`
-				ReceivedEventsURL string `json:"received_events_url"`
-				Type              string `json:"type"`
-				SiteAdmin         bool   `json:"site_admin"`
-			} `json:"user"`
-			Repo struct {
-				ID       int64  `json:"id"`
-				Name     string `json:"name"`
-				FullName string `json:"full_name"`
-				Owner    struct {
-					Login             string `json:"login"`
-					ID                int64  `json:"id"`
-					AvatarURL         string `json:"avatar_url"`
-					GravatarID        string `json:"gravatar_id"`
-					URL               string `json:"url"`
-					HTMLURL           string `json:"html_url"`
-					FollowersURL      string `json:"followers_url"`
-					FollowingURL      string `json:"following_url"`
-					GistsURL          string `json:"gists_url"`
-					StarredURL        string `json:"starred_url"`
-					SubscriptionsURL  string `json:"subscriptions_url"`
-					OrganizationsURL  string `json:"organizations_url"`
-					ReposURL          string `json:"repos_url"`
-					EventsURL         string `json:"events_url"`
-					ReceivedEventsURL string `json:"received_events_url"`
-					Type              string `json:"type"`
-					SiteAdmin         bool   `json:"site_admin"`
-				} `json:"owner"`
-				Private          bool      `json:"private"`
-				HTMLURL          string    `json:"html_url"`
-				Description      string    `json:"description"`
-				Fork             bool      `json:"fork"`
-				URL              string    `json:"url"`
-				ForksURL         string    `json:"forks_url"`
-				KeysURL          string    `json:"keys_url"`
-				CollaboratorsURL string    `json:"collaborators_url"`
-				TeamsURL         string    `json:"teams_url"`
-				HooksURL         string    `json:"hooks_url"`
-				IssueEventsURL   string    `json:"issue_events_url"`
-				EventsURL        string    `json:"events_url"`
-				AssigneesURL     string    `json:"assignees_url"`
-				BranchesURL      string    `json:"branches_url"`
-				TagsURL          string    `json:"tags_url"`
-				BlobsURL         string    `json:"blobs_url"`
-				GitTagsURL       string    `json:"git_tags_url"`
-				GitRefsURL       string    `json:"git_refs_url"`
-				TreesURL         string    `json:"trees_url"`
-				StatusesURL      string    `json:"statuses_url"`
-				LanguagesURL     string    `json:"languages_url"`
-				StargazersURL    string    `json:"stargazers_url"`
-				ContributorsURL  string    `json:"contributors_url"`
-				SubscribersURL   string    `json:"subscribers_url"`
-				SubscriptionURL  string    `json:"subscription_url"`
-				CommitsURL       string    `json:"commits_url"`
-				GitCommitsURL    string    `json:"git_commits_url"`
-				CommentsURL      string    `json:"comments_url"`
-				IssueCommentURL  string    `json:"issue_comment_url"`
-				ContentsURL      string    `json:"contents_url"`
-				CompareURL       string    `json:"compare_url"`
-				MergesURL        string    `json:"merges_url"`
-				ArchiveURL       string    `json:"archive_url"`
-				DownloadsURL     string    `json:"downloads_url"`
-				IssuesURL        string    `json:"issues_url"`
-				PullsURL         string    `json:"pulls_url"`
-				MilestonesURL    string    `json:"milestones_url"`
-				NotificationsURL string    `json:"notifications_url"`
-				LabelsURL        string    `json:"labels_url"`
-				ReleasesURL      string    `json:"releases_url"`
-				CreatedAt        time.Time `json:"created_at"`
-				UpdatedAt        time.Time `json:"updated_at"`
-				PushedAt         time.Time `json:"pushed_at"`
-				GitURL           string    `json:"git_url"`
-				SSHURL           string    `json:"ssh_url"`
-				CloneURL         string    `json:"clone_url"`
-				SvnURL           string    `json:"svn_url"`
-				Homepage         *string   `json:"homepage"`
-				Size             int64     `json:"size"`
-				StargazersCount  int64     `json:"stargazers_count"`
-				WatchersCount    int64     `json:"watchers_count"`
-				Language         *string   `json:"language"`
-				HasIssues        bool      `json:"has_issues"`
-				HasDownloads     bool      `json:"has_downloads"`
-				HasWiki          bool      `json:"has_wiki"`
-				HasPages         bool      `json:"has_pages"`
-				ForksCount       int64     `json:"forks_count"`
-				MirrorURL        *string   `json:"mirror_url"`
-				OpenIssuesCount  int64     `json:"open_issues_count"`
-				Forks            int64     `json:"forks"`
-				OpenIssues       int64     `json:"open_issues"`
-				Watchers         int64     `json:"watchers"`
-				DefaultBranch    string    `json:"default_branch"`
-			} `json:"repo"`
-		} `json:"base"`
-		Links struct {
-			Self struct {
-				Href string `json:"href"`
-			} `json:"self"`
-			HTML struct {
-				Href string `json:"href"`
-			} `json:"html"`
-			Issue struct {
-				Href string `json:"href"`
-			} `json:"issue"`
-			Comments struct {
-				Href string `json:"href"`
-			} `json:"comments"`
-			ReviewComments struct {
-				Href string `json:"href"`
-			} `json:"review_comments"`
-			ReviewComment struct {
-				Href string `json:"href"`
-			} `json:"review_comment"`
-			Commits struct {
-				Href string `json:"href"`
-			} `json:"commits"`
-			Statuses struct {
-				Href string `json:"href"`
-			} `json:"statuses"`
-		} `json:"_links"`
-		Merged         bool      `json:"merged"`
-		Mergeable      *bool     `json:"mergeable"`
-		MergeableState string    `json:"mergeable_state"`
-		MergedBy       *MergedBy `json:"merged_by"`
-		Comments       int64     `json:"comments"`
-		ReviewComments int64     `json:"review_comments"`
-		Commits        int64     `json:"commits"`
-		Additions      int64     `json:"additions"`
-		Deletions      int64     `json:"deletions"`
-		ChangedFiles   int64     `json:"changed_files"`
-	} `json:"pull_request"`
-	Label struct {
-		ID      int64  `json:"id"`
-		URL     string `json:"url"`
-		Name    string `json:"name"`
-		Color   string `json:"color"`
-		Default bool   `json:"default"`
-	} `json:"label"`
+		} `json:"creator"`
+		OpenIssues   int64      `json:"open_issues"`
+		ClosedIssues int64      `json:"closed_issues"`
+		State        string     `json:"state"`
+		CreatedAt    time.Time  `json:"created_at"`
+		UpdatedAt    time.Time  `json:"updated_at"`
+		DueOn        *time.Time `json:"due_on"`
+		ClosedAt     *time.Time `json:"closed_at"`
+	} `json:"milestone"`
+	// Changes holds the previous values of the fields the edited action changed
+	Changes    *Changes `json:"changes,omitempty"`
 	Repository struct {
 		ID       int64  `json:"id"`
 		Name     string `json:"name"`
@@ -3082,7 +3333,7 @@ type PullRequestPayload struct {
 		} `json:"owner"`
 		Private          bool      `json:"private"`
 		HTMLURL          string    `json:"html_url"`
-		Description      string    `json:"description"`
+		Description      *string   `json:"description"`
 		Fork             bool      `json:"fork"`
 		URL              string    `json:"url"`
 		ForksURL         string    `json:"forks_url"`
@@ -3120,6 +3371,7 @@ type PullRequestPayload struct {
 		NotificationsURL string    `json:"notifications_url"`
 		LabelsURL        string    `json:"labels_url"`
 		ReleasesURL      string    `json:"releases_url"`
+		DeploymentsURL   string    `json:"deployments_url"`
 		CreatedAt        time.Time `json:"created_at"`
 		UpdatedAt        time.Time `json:"updated_at"`
 		PushedAt         time.Time `json:"pushed_at"`
@@ -3144,6 +3396,19 @@ type PullRequestPayload struct {
 		Watchers         int64     `json:"watchers"`
 		DefaultBranch    string    `json:"default_branch"`
 	} `json:"repository"`
+	Organization struct {
+		Login            string `json:"login"`
+		ID               int64  `json:"id"`
+		URL              string `json:"url"`
+		ReposURL         string `json:"repos_url"`
+		EventsURL        string `json:"events_url"`
+		HooksURL         string `json:"hooks_url"`
+		IssuesURL        string `json:"issues_url"`
+		MembersURL       string `json:"members_url"`
+		PublicMembersURL string `json:"public_members_url"`
+		AvatarURL        string `json:"avatar_url"`
+		Description      string `json:"description"`
+	} `json:"organization"`
 	Sender struct {
 		Login             string `json:"login"`
 		ID                int64  `json:"id"`
@@ -3163,61 +3428,24 @@ type PullRequestPayload struct {
 		Type              string `json:"type"`
 		SiteAdmin         bool   `json:"site_admin"`
 	} `json:"sender"`
-	Installation struct {
-		ID int64 `json:"id"`
-	} `json:"installation"`
+	Installation Installation `json:"installation"`
 }
 
-// PullRequestReviewPayload contains the information for GitHub's pull_request_review hook event
-type PullRequestReviewPayload struct {
-	Action string `json:"action"`
-	Review struct {
-		ID   int64 `json:"id"`
-		User struct {
-			Login             string `json:"login"`
-			ID                int64  `json:"id"`
-			AvatarURL         string `json:"avatar_url"`
-			GravatarID        string `json:"gravatar_id"`
-			URL               string `json:"url"`
-			HTMLURL           string `json:"html_url"`
-			FollowersURL      string `json:"followers_url"`
-			FollowingURL      string `json:"following_url"`
-			GistsURL          string `json:"gists_url"`
-			StarredURL        string `json:"starred_url"`
-			SubscriptionsURL  string `json:"subscriptions_url"`
-			OrganizationsURL  string `json:"organizations_url"`
-			ReposURL          string `json:"repos_url"`
-			EventsURL         string `json:"events_url"`
-			ReceivedEventsURL string `json:"received_events_url"`
-			Type              string `json:"type"`
-			SiteAdmin         bool   `json:"site_admin"`
-		} `json:"user"`
-		Body           string    `json:"body"`
-		SubmittedAt    time.Time `json:"submitted_at"`
-		State          string    `json:"state"`
-		HTMLURL        string    `json:"html_url"`
-		PullRequestURL string    `json:"pull_request_url"`
-		Links          struct {
-			HTML struct {
-				Href string `json:"href"`
-			} `json:"html"`
-			PullRequest struct {
-				Href string `json:"href"`
-			} `json:"pull_request"`
-		} `json:"_links"`
-	} `json:"review"`
-	PullRequest struct {
-		URL      string `json:"url"`
-		ID       int64  `json:"id"`
-		HTMLURL  string `json:"html_url"`
-		DiffURL  string `json:"diff_url"`
-		PatchURL string `json:"patch_url"`
-		IssueURL string `json:"issue_url"`
-		Number   int64  `json:"number"`
-		State    string `json:"state"`
-		Locked   bool   `json:"locked"`
-		Title    string `json:"title"`
-		User     struct {
+// OrganizationPayload contains the information for GitHub's organization hook event
+type OrganizationPayload struct {
+	Action     string `json:"action"`
+	Invitation struct {
+		ID    int64   `json:"id"`
+		Login string  `json:"login"`
+		Email *string `json:"email"`
+		Role  string  `json:"role"`
+	} `json:"invitation"`
+	Membership struct {
+		URL             string `json:"url"`
+		State           string `json:"state"`
+		Role            string `json:"role"`
+		OrganizationURL string `json:"organization_url"`
+		User            struct {
 			Login             string `json:"login"`
 			ID                int64  `json:"id"`
 			AvatarURL         string `json:"avatar_url"`
@@ -3236,46 +3464,1979 @@ type PullRequestReviewPayload struct {
 			Type              string `json:"type"`
 			SiteAdmin         bool   `json:"site_admin"`
 		} `json:"user"`
-		Body              string     `json:"body"`
-		CreatedAt         time.Time  `json:"created_at"`
-		UpdatedAt         time.Time  `json:"updated_at"`
-		ClosedAt          *time.Time `json:"closed_at"`
-		MergedAt          *time.Time `json:"merged_at"`
-		MergeCommitSha    string     `json:"merge_commit_sha"`
-		Assignee          *Assignee  `json:"assignee"`
-		Assignees         []Assignee `json:"assignees"`
-		Milestone         *Milestone `json:"milestone"`
-		CommitsURL        string     `json:"commits_url"`
-		ReviewCommentsURL string     `json:"review_comments_url"`
-		ReviewCommentURL  string     `json:"review_comment_url"`
-		CommentsURL       string     `json:"comments_url"`
-		StatusesURL       string     `json:"statuses_url"`
-		Head              struct {
-			Label string `json:"label"`
-			Ref   string `json:"ref"`
-			Sha   string `json:"sha"`
-			User  struct {
-				Login             string `json:"login"`
-				ID                int64  `json:"id"`
-				AvatarURL         string `json:"avatar_url"`
-				GravatarID        string `json:"gravatar_id"`
-				URL               string `json:"url"`
-				HTMLURL           string `json:"html_url"`
-				FollowersURL      string `json:"followers_url"`
-				FollowingURL      string `json:"following_url"`
-				GistsURL          string `json:"gists_url"`
-				StarredURL        string `json:"starred_url"`
-				SubscriptionsURL  string `json:"subscriptions_url"`
-				OrganizationsURL  string `json:"organizations_url"`
-				ReposURL          string `json:"repos_url"`
-				EventsURL         string `json:"events_url"`
-				ReceivedEventsURL string `json:"received_events_url"`
-				Type              string `json:"type"`
-				SiteAdmin         bool   `json:"site_admin"`
-			} `json:"user"`
-			Repo struct {
-				ID       int64  `json:"id"`
-				Name     string `json:"name"`
+	} `json:"membership"`
+	Organization struct {
+		Login            string `json:"login"`
+		ID               int64  `json:"id"`
+		URL              string `json:"url"`
+		ReposURL         string `json:"repos_url"`
+		EventsURL        string `json:"events_url"`
+		HooksURL         string `json:"hooks_url"`
+		IssuesURL        string `json:"issues_url"`
+		MembersURL       string `json:"members_url"`
+		PublicMembersURL string `json:"public_members_url"`
+		AvatarURL        string `json:"avatar_url"`
+		Description      string `json:"description"`
+	} `json:"organization"`
+	Sender struct {
+		Login             string `json:"login"`
+		ID                int64  `json:"id"`
+		AvatarURL         string `json:"avatar_url"`
+		GravatarID        string `json:"gravatar_id"`
+		URL               string `json:"url"`
+		HTMLURL           string `json:"html_url"`
+		FollowersURL      string `json:"followers_url"`
+		FollowingURL      string `json:"following_url"`
+		GistsURL          string `json:"gists_url"`
+		StarredURL        string `json:"starred_url"`
+		SubscriptionsURL  string `json:"subscriptions_url"`
+		OrganizationsURL  string `json:"organizations_url"`
+		ReposURL          string `json:"repos_url"`
+		EventsURL         string `json:"events_url"`
+		ReceivedEventsURL string `json:"received_events_url"`
+		Type              string `json:"type"`
+		SiteAdmin         bool   `json:"site_admin"`
+	} `json:"sender"`
+	Installation Installation `json:"installation"`
+}
+
+// OrgBlockPayload contains the information for GitHub's org_block hook event
+type OrgBlockPayload struct {
+	Action      string `json:"action"`
+	BlockedUser struct {
+		Login             string `json:"login"`
+		ID                int64  `json:"id"`
+		NodeID            string `json:"node_id"`
+		AvatarURL         string `json:"avatar_url"`
+		GravatarID        string `json:"gravatar_id"`
+		URL               string `json:"url"`
+		HTMLURL           string `json:"html_url"`
+		FollowersURL      string `json:"followers_url"`
+		FollowingURL      string `json:"following_url"`
+		GistsURL          string `json:"gists_url"`
+		StarredURL        string `json:"starred_url"`
+		SubscriptionsURL  string `json:"subscriptions_url"`
+		OrganizationsURL  string `json:"organizations_url"`
+		ReposURL          string `json:"repos_url"`
+		EventsURL         string `json:"events_url"`
+		ReceivedEventsURL string `json:"received_events_url"`
+		Type              string `json:"type"`
+		SiteAdmin         bool   `json:"site_admin"`
+	} `json:"blocked_user"`
+	Organization struct {
+		Login            string `json:"login"`
+		ID               int64  `json:"id"`
+		NodeID           string `json:"node_id"`
+		URL              string `json:"url"`
+		ReposURL         string `json:"repos_url"`
+		EventsURL        string `json:"events_url"`
+		HooksURL         string `json:"hooks_url"`
+		IssuesURL        string `json:"issues_url"`
+		MembersURL       string `json:"members_url"`
+		PublicMembersURL string `json:"public_members_url"`
+		AvatarURL        string `json:"avatar_url"`
+		Description      string `json:"description"`
+	} `json:"organization"`
+	Sender struct {
+		Login             string `json:"login"`
+		ID                int64  `json:"id"`
+		NodeID            string `json:"node_id"`
+		AvatarURL         string `json:"avatar_url"`
+		GravatarID        string `json:"gravatar_id"`
+		URL               string `json:"url"`
+		HTMLURL           string `json:"html_url"`
+		FollowersURL      string `json:"followers_url"`
+		FollowingURL      string `json:"following_url"`
+		GistsURL          string `json:"gists_url"`
+		StarredURL        string `json:"starred_url"`
+		SubscriptionsURL  string `json:"subscriptions_url"`
+		OrganizationsURL  string `json:"organizations_url"`
+		ReposURL          string `json:"repos_url"`
+		EventsURL         string `json:"events_url"`
+		ReceivedEventsURL string `json:"received_events_url"`
+		Type              string `json:"type"`
+		SiteAdmin         bool   `json:"site_admin"`
+	} `json:"sender"`
+	Installation Installation `json:"installation"`
+}
+
+// PackagePayload contains the information for GitHub's package hook event
+type PackagePayload struct {
+	Action  string `json:"action"`
+	Package struct {
+		ID          int64     `json:"id"`
+		Name        string    `json:"name"`
+		Namespace   string    `json:"namespace"`
+		Description *string   `json:"description"`
+		Ecosystem   string    `json:"ecosystem"`
+		PackageType string    `json:"package_type"`
+		HTMLURL     string    `json:"html_url"`
+		CreatedAt   time.Time `json:"created_at"`
+		UpdatedAt   time.Time `json:"updated_at"`
+		Owner       struct {
+			Login             string `json:"login"`
+			ID                int64  `json:"id"`
+			AvatarURL         string `json:"avatar_url"`
+			GravatarID        string `json:"gravatar_id"`
+			URL               string `json:"url"`
+			HTMLURL           string `json:"html_url"`
+			FollowersURL      string `json:"followers_url"`
+			FollowingURL      string `json:"following_url"`
+			GistsURL          string `json:"gists_url"`
+			StarredURL        string `json:"starred_url"`
+			SubscriptionsURL  string `json:"subscriptions_url"`
+			OrganizationsURL  string `json:"organizations_url"`
+			ReposURL          string `json:"repos_url"`
+			EventsURL         string `json:"events_url"`
+			ReceivedEventsURL string `json:"received_events_url"`
+			Type              string `json:"type"`
+			SiteAdmin         bool   `json:"site_admin"`
+		} `json:"owner"`
+		PackageVersion struct {
+			ID                  int64     `json:"id"`
+			Version             string    `json:"version"`
+			Name                string    `json:"name"`
+			Summary             string    `json:"summary"`
+			Body                string    `json:"body"`
+			HTMLURL             string    `json:"html_url"`
+			TargetCommitish     string    `json:"target_commitish"`
+			TargetOid           string    `json:"target_oid"`
+			CreatedAt           time.Time `json:"created_at"`
+			UpdatedAt           time.Time `json:"updated_at"`
+			InstallationCommand string    `json:"installation_command"`
+			PackageURL          string    `json:"package_url"`
+		} `json:"package_version"`
+		Registry struct {
+			AboutURL string `json:"about_url"`
+			Name     string `json:"name"`
+			Type     string `json:"type"`
+			URL      string `json:"url"`
+			Vendor   string `json:"vendor"`
+		} `json:"registry"`
+	} `json:"package"`
+	Repository struct {
+		ID       int64  `json:"id"`
+		Name     string `json:"name"`
+		FullName string `json:"full_name"`
+		Owner    struct {
+			Login             string `json:"login"`
+			ID                int64  `json:"id"`
+			AvatarURL         string `json:"avatar_url"`
+			GravatarID        string `json:"gravatar_id"`
+			URL               string `json:"url"`
+			HTMLURL           string `json:"html_url"`
+			FollowersURL      string `json:"followers_url"`
+			FollowingURL      string `json:"following_url"`
+			GistsURL          string `json:"gists_url"`
+			StarredURL        string `json:"starred_url"`
+			SubscriptionsURL  string `json:"subscriptions_url"`
+			OrganizationsURL  string `json:"organizations_url"`
+			ReposURL          string `json:"repos_url"`
+			EventsURL         string `json:"events_url"`
+			ReceivedEventsURL string `json:"received_events_url"`
+			Type              string `json:"type"`
+			SiteAdmin         bool   `json:"site_admin"`
+		} `json:"owner"`
+		Private          bool      `json:"private"`
+		HTMLURL          string    `json:"html_url"`
+		Description      string    `json:"description"`
+		Fork             bool      `json:"fork"`
+		URL              string    `json:"url"`
+		ForksURL         string    `json:"forks_url"`
+		KeysURL          string    `json:"keys_url"`
+		CollaboratorsURL string    `json:"collaborators_url"`
+		TeamsURL         string    `json:"teams_url"`
+		HooksURL         string    `json:"hooks_url"`
+		IssueEventsURL   string    `json:"issue_events_url"`
+		EventsURL        string    `json:"events_url"`
+		AssigneesURL     string    `json:"assignees_url"`
+		BranchesURL      string    `json:"branches_url"`
+		TagsURL          string    `json:"tags_url"`
+		BlobsURL         string    `json:"blobs_url"`
+		GitTagsURL       string    `json:"git_tags_url"`
+		GitRefsURL       string    `json:"git_refs_url"`
+		TreesURL         string    `json:"trees_url"`
+		StatusesURL      string    `json:"statuses_url"`
+		LanguagesURL     string    `json:"languages_url"`
+		StargazersURL    string    `json:"stargazers_url"`
+		ContributorsURL  string    `json:"contributors_url"`
+		SubscribersURL   string    `json:"subscribers_url"`
+		SubscriptionURL  string    `json:"subscription_url"`
+		CommitsURL       string    `json:"commits_url"`
+		GitCommitsURL    string    `json:"git_commits_url"`
+		CommentsURL      string    `json:"comments_url"`
+		IssueCommentURL  string    `json:"issue_comment_url"`
+		ContentsURL      string    `json:"contents_url"`
+		CompareURL       string    `json:"compare_url"`
+		MergesURL        string    `json:"merges_url"`
+		ArchiveURL       string    `json:"archive_url"`
+		DownloadsURL     string    `json:"downloads_url"`
+		IssuesURL        string    `json:"issues_url"`
+		PullsURL         string    `json:"pulls_url"`
+		MilestonesURL    string    `json:"milestones_url"`
+		NotificationsURL string    `json:"notifications_url"`
+		LabelsURL        string    `json:"labels_url"`
+		ReleasesURL      string    `json:"releases_url"`
+		CreatedAt        time.Time `json:"created_at"`
+		UpdatedAt        time.Time `json:"updated_at"`
+		PushedAt         time.Time `json:"pushed_at"`
+		GitURL           string    `json:"git_url"`
+		SSHURL           string    `json:"ssh_url"`
+		CloneURL         string    `json:"clone_url"`
+		SvnURL           string    `json:"svn_url"`
+		Homepage         *string   `json:"homepage"`
+		Size             int64     `json:"size"`
+		StargazersCount  int64     `json:"stargazers_count"`
+		WatchersCount    int64     `json:"watchers_count"`
+		Language         *string   `json:"language"`
+		HasIssues        bool      `json:"has_issues"`
+		HasDownloads     bool      `json:"has_downloads"`
+		HasWiki          bool      `json:"has_wiki"`
+		HasPages         bool      `json:"has_pages"`
+		ForksCount       int64     `json:"forks_count"`
+		MirrorURL        *string   `json:"mirror_url"`
+		OpenIssuesCount  int64     `json:"open_issues_count"`
+		Forks            int64     `json:"forks"`
+		OpenIssues       int64     `json:"open_issues"`
+		Watchers         int64     `json:"watchers"`
+		DefaultBranch    string    `json:"default_branch"`
+	} `json:"repository"`
+	Sender struct {
+		Login             string `json:"login"`
+		ID                int64  `json:"id"`
+		AvatarURL         string `json:"avatar_url"`
+		GravatarID        string `json:"gravatar_id"`
+		URL               string `json:"url"`
+		HTMLURL           string `json:"html_url"`
+		FollowersURL      string `json:"followers_url"`
+		FollowingURL      string `json:"following_url"`
+		GistsURL          string `json:"gists_url"`
+		StarredURL        string `json:"starred_url"`
+		SubscriptionsURL  string `json:"subscriptions_url"`
+		OrganizationsURL  string `json:"organizations_url"`
+		ReposURL          string `json:"repos_url"`
+		EventsURL         string `json:"events_url"`
+		ReceivedEventsURL string `json:"received_events_url"`
+		Type              string `json:"type"`
+		SiteAdmin         bool   `json:"site_admin"`
+	} `json:"sender"`
+	Installation Installation `json:"installation"`
+}
+
+// PageBuildPayload contains the information for GitHub's page_build hook event
+type PageBuildPayload struct {
+	ID    int64 `json:"id"`
+	Build struct {
+		URL    string `json:"url"`
+		Status string `json:"status"`
+		Error  struct {
+			Message *string `json:"message"`
+		} `json:"error"`
+		Pusher struct {
+			Login             string `json:"login"`
+			ID                int64  `json:"id"`
+			AvatarURL         string `json:"avatar_url"`
+			GravatarID        string `json:"gravatar_id"`
+			URL               string `json:"url"`
+			HTMLURL           string `json:"html_url"`
+			FollowersURL      string `json:"followers_url"`
+			FollowingURL      string `json:"following_url"`
+			GistsURL          string `json:"gists_url"`
+			StarredURL        string `json:"starred_url"`
+			SubscriptionsURL  string `json:"subscriptions_url"`
+			OrganizationsURL  string `json:"organizations_url"`
+			ReposURL          string `json:"repos_url"`
+			EventsURL         string `json:"events_url"`
+			ReceivedEventsURL string `json:"received_events_url"`
+			Type              string `json:"type"`
+			SiteAdmin         bool   `json:"site_admin"`
+		} `json:"pusher"`
+		Commit    string    `json:"commit"`
+		Duration  int64     `json:"duration"`
+		CreatedAt time.Time `json:"created_at"`
+		UpdatedAt time.Time `json:"updated_at"`
+	} `json:"build"`
+	Repository struct {
+		ID       int64  `json:"id"`
+		Name     string `json:"name"`
+		FullName string `json:"full_name"`
+		Owner    struct {
+			Login             string `json:"login"`
+			ID                int64  `json:"id"`
+			AvatarURL         string `json:"avatar_url"`
+			GravatarID        string `json:"gravatar_id"`
+			URL               string `json:"url"`
+			HTMLURL           string `json:"html_url"`
+			FollowersURL      string `json:"followers_url"`
+			FollowingURL      string `json:"following_url"`
+			GistsURL          string `json:"gists_url"`
+			StarredURL        string `json:"starred_url"`
+			SubscriptionsURL  string `json:"subscriptions_url"`
+			OrganizationsURL  string `json:"organizations_url"`
+			ReposURL          string `json:"repos_url"`
+			EventsURL         string `json:"events_url"`
+			ReceivedEventsURL string `json:"received_events_url"`
+			Type              string `json:"type"`
+			SiteAdmin         bool   `json:"site_admin"`
+		} `json:"owner"`
+		Private          bool      `json:"private"`
+		HTMLURL          string    `json:"html_url"`
+		Description      string    `json:"description"`
+		Fork             bool      `json:"fork"`
+		URL              string    `json:"url"`
+		ForksURL         string    `json:"forks_url"`
+		KeysURL          string    `json:"keys_url"`
+		CollaboratorsURL string    `json:"collaborators_url"`
+		TeamsURL         string    `json:"teams_url"`
+		HooksURL         string    `json:"hooks_url"`
+		IssueEventsURL   string    `json:"issue_events_url"`
+		EventsURL        string    `json:"events_url"`
+		AssigneesURL     string    `json:"assignees_url"`
+		BranchesURL      string    `json:"branches_url"`
+		TagsURL          string    `json:"tags_url"`
+		BlobsURL         string    `json:"blobs_url"`
+		GitTagsURL       string    `json:"git_tags_url"`
+		GitRefsURL       string    `json:"git_refs_url"`
+		TreesURL         string    `json:"trees_url"`
+		StatusesURL      string    `json:"statuses_url"`
+		LanguagesURL     string    `json:"languages_url"`
+		StargazersURL    string    `json:"stargazers_url"`
+		ContributorsURL  string    `json:"contributors_url"`
+		SubscribersURL   string    `json:"subscribers_url"`
+		SubscriptionURL  string    `json:"subscription_url"`
+		CommitsURL       string    `json:"commits_url"`
+		GitCommitsURL    string    `json:"git_commits_url"`
+		CommentsURL      string    `json:"comments_url"`
+		IssueCommentURL  string    `json:"issue_comment_url"`
+		ContentsURL      string    `json:"contents_url"`
+		CompareURL       string    `json:"compare_url"`
+		MergesURL        string    `json:"merges_url"`
+		ArchiveURL       string    `json:"archive_url"`
+		DownloadsURL     string    `json:"downloads_url"`
+		IssuesURL        string    `json:"issues_url"`
+		PullsURL         string    `json:"pulls_url"`
+		MilestonesURL    string    `json:"milestones_url"`
+		NotificationsURL string    `json:"notifications_url"`
+		LabelsURL        string    `json:"labels_url"`
+		ReleasesURL      string    `json:"releases_url"`
+		CreatedAt        time.Time `json:"created_at"`
+		UpdatedAt        time.Time `json:"updated_at"`
+		PushedAt         time.Time `json:"pushed_at"`
+		GitURL           string    `json:"git_url"`
+		SSHURL           string    `json:"ssh_url"`
+		CloneURL         string    `json:"clone_url"`
+		SvnURL           string    `json:"svn_url"`
+		Homepage         *string   `json:"homepage"`
+		Size             int64     `json:"size"`
+		StargazersCount  int64     `json:"stargazers_count"`
+		WatchersCount    int64     `json:"watchers_count"`
+		Language         *string   `json:"language"`
+		HasIssues        bool      `json:"has_issues"`
+		HasDownloads     bool      `json:"has_downloads"`
+		HasWiki          bool      `json:"has_wiki"`
+		HasPages         bool      `json:"has_pages"`
+		ForksCount       int64     `json:"forks_count"`
+		MirrorURL        *string   `json:"mirror_url"`
+		OpenIssuesCount  int64     `json:"open_issues_count"`
+		Forks            int64     `json:"forks"`
+		OpenIssues       int64     `json:"open_issues"`
+		Watchers         int64     `json:"watchers"`
+		DefaultBranch    string    `json:"default_branch"`
+	} `json:"repository"`
+	Sender struct {
+		Login             string `json:"login"`
+		ID                int64  `json:"id"`
+		AvatarURL         string `json:"avatar_url"`
+		GravatarID        string `json:"gravatar_id"`
+		URL               string `json:"url"`
+		HTMLURL           string `json:"html_url"`
+		FollowersURL      string `json:"followers_url"`
+		FollowingURL      string `json:"following_url"`
+		GistsURL          string `json:"gists_url"`
+		StarredURL        string `json:"starred_url"`
+		SubscriptionsURL  string `json:"subscriptions_url"`
+		OrganizationsURL  string `json:"organizations_url"`
+		ReposURL          string `json:"repos_url"`
+		EventsURL         string `json:"events_url"`
+		ReceivedEventsURL string `json:"received_events_url"`
+		Type              string `json:"type"`
+		SiteAdmin         bool   `json:"site_admin"`
+	} `json:"sender"`
+	Installation Installation `json:"installation"`
+}
+
+// PingPayload contains the information for GitHub's ping hook event
+type PingPayload struct {
+	Zen    string `json:"zen"`
+	HookID int    `json:"hook_id"`
+	Hook   struct {
+		Type   string   `json:"type"`
+		ID     int64    `json:"id"`
+		Name   string   `json:"name"`
+		Active bool     `json:"active"`
+		Events []string `json:"events"`
+		AppID  int      `json:"app_id"`
+		Config struct {
+			ContentType string `json:"content_type"`
+			InsecureSSL string `json:"insecure_ssl"`
+			Secret      string `json:"secret"`
+			URL         string `json:"url"`
+		} `json:"config"`
+		CreatedAt time.Time `json:"created_at"`
+		UpdatedAt time.Time `json:"updated_at"`
+	} `json:"hook"`
+}
+
+// ProjectCardPayload contains the information for GitHub's project_payload hook event
+type ProjectCardPayload struct {
+	Action      string `json:"action"`
+	ProjectCard struct {
+		URL       string  `json:"url"`
+		ColumnURL string  `json:"column_url"`
+		ColumnID  int64   `json:"column_id"`
+		ID        int64   `json:"id"`
+		Note      *string `json:"note"`
+		Creator   struct {
+			Login             string `json:"login"`
+			ID                int64  `json:"id"`
+			AvatarURL         string `json:"avatar_url"`
+			GravatarID        string `json:"gravatar_id"`
+			URL               string `json:"url"`
+			HTMLURL           string `json:"html_url"`
+			FollowersURL      string `json:"followers_url"`
+			FollowingURL      string `json:"following_url"`
+			GistsURL          string `json:"gists_url"`
+			StarredURL        string `json:"starred_url"`
+			SubscriptionsURL  string `json:"subscriptions_url"`
+			OrganizationsURL  string `json:"organizations_url"`
+			ReposURL          string `json:"repos_url"`
+			EventsURL         string `json:"events_url"`
+			ReceivedEventsURL string `json:"received_events_url"`
+			Type              string `json:"type"`
+			SiteAdmin         bool   `json:"site_admin"`
+		} `json:"creator"`
+		CreatedAt  int64  `json:"created_at"`
+		UpdatedAt  int64  `json:"updated_at"`
+		ContentURL string `json:"content_url"`
+	} `json:"project_card"`
+	Repository struct {
+		ID       int64  `json:"id"`
+		Name     string `json:"name"`
+		FullName string `json:"full_name"`
+		Owner    struct {
+			Login             string `json:"login"`
+			ID                int64  `json:"id"`
+			AvatarURL         string `json:"avatar_url"`
+			GravatarID        string `json:"gravatar_id"`
+			URL               string `json:"url"`
+			HTMLURL           string `json:"html_url"`
+			FollowersURL      string `json:"followers_url"`
+			FollowingURL      string `json:"following_url"`
+			GistsURL          string `json:"gists_url"`
+			StarredURL        string `json:"starred_url"`
+			SubscriptionsURL  string `json:"subscriptions_url"`
+			OrganizationsURL  string `json:"organizations_url"`
+			ReposURL          string `json:"repos_url"`
+			EventsURL         string `json:"events_url"`
+			ReceivedEventsURL string `json:"received_events_url"`
+			Type              string `json:"type"`
+			SiteAdmin         bool   `json:"site_admin"`
+		} `json:"owner"`
+		Private          bool      `json:"private"`
+		HTMLURL          string    `json:"html_url"`
+		Description      string    `json:"description"`
+		Fork             bool      `json:"fork"`
+		URL              string    `json:"url"`
+		ForksURL         string    `json:"forks_url"`
+		KeysURL          string    `json:"keys_url"`
+		CollaboratorsURL string    `json:"collaborators_url"`
+		TeamsURL         string    `json:"teams_url"`
+		HooksURL         string    `json:"hooks_url"`
+		IssueEventsURL   string    `json:"issue_events_url"`
+		EventsURL        string    `json:"events_url"`
+		AssigneesURL     string    `json:"assignees_url"`
+		BranchesURL      string    `json:"branches_url"`
+		TagsURL          string    `json:"tags_url"`
+		BlobsURL         string    `json:"blobs_url"`
+		GitTagsURL       string    `json:"git_tags_url"`
+		GitRefsURL       string    `json:"git_refs_url"`
+		TreesURL         string    `json:"trees_url"`
+		StatusesURL      string    `json:"statuses_url"`
+		LanguagesURL     string    `json:"languages_url"`
+		StargazersURL    string    `json:"stargazers_url"`
+		ContributorsURL  string    `json:"contributors_url"`
+		SubscribersURL   string    `json:"subscribers_url"`
+		SubscriptionURL  string    `json:"subscription_url"`
+		CommitsURL       string    `json:"commits_url"`
+		GitCommitsURL    string    `json:"git_commits_url"`
+		CommentsURL      string    `json:"comments_url"`
+		IssueCommentURL  string    `json:"issue_comment_url"`
+		ContentsURL      string    `json:"contents_url"`
+		CompareURL       string    `json:"compare_url"`
+		MergesURL        string    `json:"merges_url"`
+		ArchiveURL       string    `json:"archive_url"`
+		DownloadsURL     string    `json:"downloads_url"`
+		IssuesURL        string    `json:"issues_url"`
+		PullsURL         string    `json:"pulls_url"`
+		MilestonesURL    string    `json:"milestones_url"`
+		NotificationsURL string    `json:"notifications_url"`
+		LabelsURL        string    `json:"labels_url"`
+		ReleasesURL      string    `json:"releases_url"`
+		CreatedAt        time.Time `json:"created_at"`
+		UpdatedAt        time.Time `json:"updated_at"`
+		PushedAt         time.Time `json:"pushed_at"`
+		GitURL           string    `json:"git_url"`
+		SSHURL           string    `json:"ssh_url"`
+		CloneURL         string    `json:"clone_url"`
+		SvnURL           string    `json:"svn_url"`
+		Homepage         *string   `json:"homepage"`
+		Size             int64     `json:"size"`
+		StargazersCount  int64     `json:"stargazers_count"`
+		WatchersCount    int64     `json:"watchers_count"`
+		Language         *string   `json:"language"`
+		HasIssues        bool      `json:"has_issues"`
+		HasDownloads     bool      `json:"has_downloads"`
+		HasWiki          bool      `json:"has_wiki"`
+		HasPages         bool      `json:"has_pages"`
+		ForksCount       int64     `json:"forks_count"`
+		MirrorURL        *string   `json:"mirror_url"`
+		OpenIssuesCount  int64     `json:"open_issues_count"`
+		Forks            int64     `json:"forks"`
+		OpenIssues       int64     `json:"open_issues"`
+		Watchers         int64     `json:"watchers"`
+		DefaultBranch    string    `json:"default_branch"`
+	} `json:"repository"`
+	Organization struct {
+		Login            string `json:"login"`
+		ID               int64  `json:"id"`
+		URL              string `json:"url"`
+		ReposURL         string `json:"repos_url"`
+		EventsURL        string `json:"events_url"`
+		MembersURL       string `json:"members_url"`
+		PublicMembersURL string `json:"public_members_url"`
+		AvatarURL        string `json:"avatar_url"`
+	} `json:"organization"`
+	Sender struct {
+		Login             string `json:"login"`
+		ID                int64  `json:"id"`
+		AvatarURL         string `json:"avatar_url"`
+		GravatarID        string `json:"gravatar_id"`
+		URL               string `json:"url"`
+		HTMLURL           string `json:"html_url"`
+		FollowersURL      string `json:"followers_url"`
+		FollowingURL      string `json:"following_url"`
+		GistsURL          string `json:"gists_url"`
+		StarredURL        string `json:"starred_url"`
+		SubscriptionsURL  string `json:"subscriptions_url"`
+		OrganizationsURL  string `json:"organizations_url"`
+		ReposURL          string `json:"repos_url"`
+		EventsURL         string `json:"events_url"`
+		ReceivedEventsURL string `json:"received_events_url"`
+		Type              string `json:"type"`
+		SiteAdmin         bool   `json:"site_admin"`
+	} `json:"sender"`
+	Installation Installation `json:"installation"`
+}
+
+// ProjectColumnPayload contains the information for GitHub's project_column hook event
+type ProjectColumnPayload struct {
+	Action        string `json:"action"`
+	ProjectColumn struct {
+		URL        string `json:"url"`
+		ProjectURL string `json:"project_url"`
+		CardsURL   string `json:"cards_url"`
+		ID         int64  `json:"id"`
+		Name       string `json:"name"`
+		CreatedAt  int64  `json:"created_at"`
+		UpdatedAt  int64  `json:"updated_at"`
+	} `json:"project_column"`
+	Repository struct {
+		ID       int64  `json:"id"`
+		Name     string `json:"name"`
+		FullName string `json:"full_name"`
+		Owner    struct {
+			Login             string `json:"login"`
+			ID                int64  `json:"id"`
+			AvatarURL         string `json:"avatar_url"`
+			GravatarID        string `json:"gravatar_id"`
+			URL               string `json:"url"`
+			HTMLURL           string `json:"html_url"`
+			FollowersURL      string `json:"followers_url"`
+			FollowingURL      string `json:"following_url"`
+			GistsURL          string `json:"gists_url"`
+			StarredURL        string `json:"starred_url"`
+			SubscriptionsURL  string `json:"subscriptions_url"`
+			OrganizationsURL  string `json:"organizations_url"`
+			ReposURL          string `json:"repos_url"`
+			EventsURL         string `json:"events_url"`
+			ReceivedEventsURL string `json:"received_events_url"`
+			Type              string `json:"type"`
+			SiteAdmin         bool   `json:"site_admin"`
+		} `json:"owner"`
+		Private          bool      `json:"private"`
+		HTMLURL          string    `json:"html_url"`
+		Description      string    `json:"description"`
+		Fork             bool      `json:"fork"`
+		URL              string    `json:"url"`
+		ForksURL         string    `json:"forks_url"`
+		KeysURL          string    `json:"keys_url"`
+		CollaboratorsURL string    `json:"collaborators_url"`
+		TeamsURL         string    `json:"teams_url"`
+		HooksURL         string    `json:"hooks_url"`
+		IssueEventsURL   string    `json:"issue_events_url"`
+		EventsURL        string    `json:"events_url"`
+		AssigneesURL     string    `json:"assignees_url"`
+		BranchesURL      string    `json:"branches_url"`
+		TagsURL          string    `json:"tags_url"`
+		BlobsURL         string    `json:"blobs_url"`
+		GitTagsURL       string    `json:"git_tags_url"`
+		GitRefsURL       string    `json:"git_refs_url"`
+		TreesURL         string    `json:"trees_url"`
+		StatusesURL      string    `json:"statuses_url"`
+		LanguagesURL     string    `json:"languages_url"`
+		StargazersURL    string    `json:"stargazers_url"`
+		ContributorsURL  string    `json:"contributors_url"`
+		SubscribersURL   string    `json:"subscribers_url"`
+		SubscriptionURL  string    `json:"subscription_url"`
+		CommitsURL       string    `json:"commits_url"`
+		GitCommitsURL    string    `json:"git_commits_url"`
+		CommentsURL      string    `json:"comments_url"`
+		IssueCommentURL  string    `json:"issue_comment_url"`
+		ContentsURL      string    `json:"contents_url"`
+		CompareURL       string    `json:"compare_url"`
+		MergesURL        string    `json:"merges_url"`
+		ArchiveURL       string    `json:"archive_url"`
+		DownloadsURL     string    `json:"downloads_url"`
+		IssuesURL        string    `json:"issues_url"`
+		PullsURL         string    `json:"pulls_url"`
+		MilestonesURL    string    `json:"milestones_url"`
+		NotificationsURL string    `json:"notifications_url"`
+		LabelsURL        string    `json:"labels_url"`
+		ReleasesURL      string    `json:"releases_url"`
+		CreatedAt        time.Time `json:"created_at"`
+		UpdatedAt        time.Time `json:"updated_at"`
+		PushedAt         time.Time `json:"pushed_at"`
+		GitURL           string    `json:"git_url"`
+		SSHURL           string    `json:"ssh_url"`
+		CloneURL         string    `json:"clone_url"`
+		SvnURL           string    `json:"svn_url"`
+		Homepage         *string   `json:"homepage"`
+		Size             int64     `json:"size"`
+		StargazersCount  int64     `json:"stargazers_count"`
+		WatchersCount    int64     `json:"watchers_count"`
+		Language         *string   `json:"language"`
+		HasIssues        bool      `json:"has_issues"`
+		HasDownloads     bool      `json:"has_downloads"`
+		HasWiki          bool      `json:"has_wiki"`
+		HasPages         bool      `json:"has_pages"`
+		ForksCount       int64     `json:"forks_count"`
+		MirrorURL        *string   `json:"mirror_url"`
+		OpenIssuesCount  int64     `json:"open_issues_count"`
+		Forks            int64     `json:"forks"`
+		OpenIssues       int64     `json:"open_issues"`
+		Watchers         int64     `json:"watchers"`
+		DefaultBranch    string    `json:"default_branch"`
+	} `json:"repository"`
+	Organization struct {
+		Login            string `json:"login"`
+		ID               int64  `json:"id"`
+		URL              string `json:"url"`
+		ReposURL         string `json:"repos_url"`
+		EventsURL        string `json:"events_url"`
+		MembersURL       string `json:"members_url"`
+		PublicMembersURL string `json:"public_members_url"`
+		AvatarURL        string `json:"avatar_url"`
+	} `json:"organization"`
+	Sender struct {
+		Login             string `json:"login"`
+		ID                int64  `json:"id"`
+		AvatarURL         string `json:"avatar_url"`
+		GravatarID        string `json:"gravatar_id"`
+		URL               string `json:"url"`
+		HTMLURL           string `json:"html_url"`
+		FollowersURL      string `json:"followers_url"`
+		FollowingURL      string `json:"following_url"`
+		GistsURL          string `json:"gists_url"`
+		StarredURL        string `json:"starred_url"`
+		SubscriptionsURL  string `json:"subscriptions_url"`
+		OrganizationsURL  string `json:"organizations_url"`
+		ReposURL          string `json:"repos_url"`
+		EventsURL         string `json:"events_url"`
+		ReceivedEventsURL string `json:"received_events_url"`
+		Type              string `json:"type"`
+		SiteAdmin         bool   `json:"site_admin"`
+	} `json:"sender"`
+	Installation Installation `json:"installation"`
+}
+
+// ProjectPayload contains the information for GitHub's project hook event
+type ProjectPayload struct {
+	Action  string `json:"action"`
+	Project struct {
+		OwnerURL   string `json:"owner_url"`
+		URL        string `json:"url"`
+		ColumnsURL string `json:"columns_url"`
+		ID         int64  `json:"id"`
+		Name       string `json:"name"`
+		Body       string `json:"body"`
+		Number     int64  `json:"number"`
+		State      string `json:"state"`
+		Creator    struct {
+			Login             string `json:"login"`
+			ID                int64  `json:"id"`
+			AvatarURL         string `json:"avatar_url"`
+			GravatarID        string `json:"gravatar_id"`
+			URL               string `json:"url"`
+			HTMLURL           string `json:"html_url"`
+			FollowersURL      string `json:"followers_url"`
+			FollowingURL      string `json:"following_url"`
+			GistsURL          string `json:"gists_url"`
+			StarredURL        string `json:"starred_url"`
+			SubscriptionsURL  string `json:"subscriptions_url"`
+			OrganizationsURL  string `json:"organizations_url"`
+			ReposURL          string `json:"repos_url"`
+			EventsURL         string `json:"events_url"`
+			ReceivedEventsURL string `json:"received_events_url"`
+			Type              string `json:"type"`
+			SiteAdmin         bool   `json:"site_admin"`
+		} `json:"creator"`
+		CreatedAt int64 `json:"created_at"`
+		UpdatedAt int64 `json:"updated_at"`
+	} `json:"project"`
+	Repository struct {
+		ID       int64  `json:"id"`
+		Name     string `json:"name"`
+		FullName string `json:"full_name"`
+		Owner    struct {
+			Login             string `json:"login"`
+			ID                int64  `json:"id"`
+			AvatarURL         string `json:"avatar_url"`
+			GravatarID        string `json:"gravatar_id"`
+			URL               string `json:"url"`
+			HTMLURL           string `json:"html_url"`
+			FollowersURL      string `json:"followers_url"`
+			FollowingURL      string `json:"following_url"`
+			GistsURL          string `json:"gists_url"`
+			StarredURL        string `json:"starred_url"`
+			SubscriptionsURL  string `json:"subscriptions_url"`
+			OrganizationsURL  string `json:"organizations_url"`
+			ReposURL          string `json:"repos_url"`
+			EventsURL         string `json:"events_url"`
+			ReceivedEventsURL string `json:"received_events_url"`
+			Type              string `json:"type"`
+			SiteAdmin         bool   `json:"site_admin"`
+		} `json:"owner"`
+		Private          bool      `json:"private"`
+		HTMLURL          string    `json:"html_url"`
+		Description      string    `json:"description"`
+		Fork             bool      `json:"fork"`
+		URL              string    `json:"url"`
+		ForksURL         string    `json:"forks_url"`
+		KeysURL          string    `json:"keys_url"`
+		CollaboratorsURL string    `json:"collaborators_url"`
+		TeamsURL         string    `json:"teams_url"`
+		HooksURL         string    `json:"hooks_url"`
+		IssueEventsURL   string    `json:"issue_events_url"`
+		EventsURL        string    `json:"events_url"`
+		AssigneesURL     string    `json:"assignees_url"`
+		BranchesURL      string    `json:"branches_url"`
+		TagsURL          string    `json:"tags_url"`
+		BlobsURL         string    `json:"blobs_url"`
+		GitTagsURL       string    `json:"git_tags_url"`
+		GitRefsURL       string    `json:"git_refs_url"`
+		TreesURL         string    `json:"trees_url"`
+		StatusesURL      string    `json:"statuses_url"`
+		LanguagesURL     string    `json:"languages_url"`
+		StargazersURL    string    `json:"stargazers_url"`
+		ContributorsURL  string    `json:"contributors_url"`
+		SubscribersURL   string    `json:"subscribers_url"`
+		SubscriptionURL  string    `json:"subscription_url"`
+		CommitsURL       string    `json:"commits_url"`
+		GitCommitsURL    string    `json:"git_commits_url"`
+		CommentsURL      string    `json:"comments_url"`
+		IssueCommentURL  string    `json:"issue_comment_url"`
+		ContentsURL      string    `json:"contents_url"`
+		CompareURL       string    `json:"compare_url"`
+		MergesURL        string    `json:"merges_url"`
+		ArchiveURL       string    `json:"archive_url"`
+		DownloadsURL     string    `json:"downloads_url"`
+		IssuesURL        string    `json:"issues_url"`
+		PullsURL         string    `json:"pulls_url"`
+		MilestonesURL    string    `json:"milestones_url"`
+		NotificationsURL string    `json:"notifications_url"`
+		LabelsURL        string    `json:"labels_url"`
+		ReleasesURL      string    `json:"releases_url"`
+		CreatedAt        time.Time `json:"created_at"`
+		UpdatedAt        time.Time `json:"updated_at"`
+		PushedAt         time.Time `json:"pushed_at"`
+		GitURL           string    `json:"git_url"`
+		SSHURL           string    `json:"ssh_url"`
+		CloneURL         string    `json:"clone_url"`
+		SvnURL           string    `json:"svn_url"`
+		Homepage         *string   `json:"homepage"`
+		Size             int64     `json:"size"`
+		StargazersCount  int64     `json:"stargazers_count"`
+		WatchersCount    int64     `json:"watchers_count"`
+		Language         *string   `json:"language"`
+		HasIssues        bool      `json:"has_issues"`
+		HasDownloads     bool      `json:"has_downloads"`
+		HasWiki          bool      `json:"has_wiki"`
+		HasPages         bool      `json:"has_pages"`
+		ForksCount       int64     `json:"forks_count"`
+		MirrorURL        *string   `json:"mirror_url"`
+		OpenIssuesCount  int64     `json:"open_issues_count"`
+		Forks            int64     `json:"forks"`
+		OpenIssues       int64     `json:"open_issues"`
+		Watchers         int64     `json:"watchers"`
+		DefaultBranch    string    `json:"default_branch"`
+	} `json:"repository"`
+	Organization struct {
+		Login            string `json:"login"`
+		ID               int64  `json:"id"`
+		URL              string `json:"url"`
+		ReposURL         string `json:"repos_url"`
+		EventsURL        string `json:"events_url"`
+		MembersURL       string `json:"members_url"`
+		PublicMembersURL string `json:"public_members_url"`
+		AvatarURL        string `json:"avatar_url"`
+	} `json:"organization"`
+	Sender struct {
+		Login             string `json:"login"`
+		ID                int64  `json:"id"`
+		AvatarURL         string `json:"avatar_url"`
+		GravatarID        string `json:"gravatar_id"`
+		URL               string `json:"url"`
+		HTMLURL           string `json:"html_url"`
+		FollowersURL      string `json:"followers_url"`
+		FollowingURL      string `json:"following_url"`
+		GistsURL          string `json:"gists_url"`
+		StarredURL        string `json:"starred_url"`
+		SubscriptionsURL  string `json:"subscriptions_url"`
+		OrganizationsURL  string `json:"organizations_url"`
+		ReposURL          string `json:"repos_url"`
+		EventsURL         string `json:"events_url"`
+		ReceivedEventsURL string `json:"received_events_url"`
+		Type              string `json:"type"`
+		SiteAdmin         bool   `json:"site_admin"`
+	} `json:"sender"`
+	Installation Installation `json:"installation"`
+}
+
+// PublicPayload contains the information for GitHub's public hook event
+type PublicPayload struct {
+	Repository struct {
+		ID       int64  `json:"id"`
+		Name     string `json:"name"`
+		FullName string `json:"full_name"`
+		Owner    struct {
+			Login             string `json:"login"`
+			ID                int64  `json:"id"`
+			AvatarURL         string `json:"avatar_url"`
+			GravatarID        string `json:"gravatar_id"`
+			URL               string `json:"url"`
+			HTMLURL           string `json:"html_url"`
+			FollowersURL      string `json:"followers_url"`
+			FollowingURL      string `json:"following_url"`
+			GistsURL          string `json:"gists_url"`
+			StarredURL        string `json:"starred_url"`
+			SubscriptionsURL  string `json:"subscriptions_url"`
+			OrganizationsURL  string `json:"organizations_url"`
+			ReposURL          string `json:"repos_url"`
+			EventsURL         string `json:"events_url"`
+			ReceivedEventsURL string `json:"received_events_url"`
+			Type              string `json:"type"`
+			SiteAdmin         bool   `json:"site_admin"`
+		} `json:"owner"`
+		Private          bool      `json:"private"`
+		HTMLURL          string    `json:"html_url"`
+		Description      string    `json:"description"`
+		Fork             bool      `json:"fork"`
+		URL              string    `json:"url"`
+		ForksURL         string    `json:"forks_url"`
+		KeysURL          string    `json:"keys_url"`
+		CollaboratorsURL string    `json:"collaborators_url"`
+		TeamsURL         string    `json:"teams_url"`
+		HooksURL         string    `json:"hooks_url"`
+		IssueEventsURL   string    `json:"issue_events_url"`
+		EventsURL        string    `json:"events_url"`
+		AssigneesURL     string    `json:"assignees_url"`
+		BranchesURL      string    `json:"branches_url"`
+		TagsURL          string    `json:"tags_url"`
+		BlobsURL         string    `json:"blobs_url"`
+		GitTagsURL       string    `json:"git_tags_url"`
+		GitRefsURL       string    `json:"git_refs_url"`
+		TreesURL         string    `json:"trees_url"`
+		StatusesURL      string    `json:"statuses_url"`
+		LanguagesURL     string    `json:"languages_url"`
+		StargazersURL    string    `json:"stargazers_url"`
+		ContributorsURL  string    `json:"contributors_url"`
+		SubscribersURL   string    `json:"subscribers_url"`
+		SubscriptionURL  string    `json:"subscription_url"`
+		CommitsURL       string    `json:"commits_url"`
+		GitCommitsURL    string    `json:"git_commits_url"`
+		CommentsURL      string    `json:"comments_url"`
+		IssueCommentURL  string    `json:"issue_comment_url"`
+		ContentsURL      string    `json:"contents_url"`
+		CompareURL       string    `json:"compare_url"`
+		MergesURL        string    `json:"merges_url"`
+		ArchiveURL       string    `json:"archive_url"`
+		DownloadsURL     string    `json:"downloads_url"`
+		IssuesURL        string    `json:"issues_url"`
+		PullsURL         string    `json:"pulls_url"`
+		MilestonesURL    string    `json:"milestones_url"`
+		NotificationsURL string    `json:"notifications_url"`
+		LabelsURL        string    `json:"labels_url"`
+		ReleasesURL      string    `json:"releases_url"`
+		CreatedAt        time.Time `json:"created_at"`
+		UpdatedAt        time.Time `json:"updated_at"`
+		PushedAt         time.Time `json:"pushed_at"`
+		GitURL           string    `json:"git_url"`
+		SSHURL           string    `json:"ssh_url"`
+		CloneURL         string    `json:"clone_url"`
+		SvnURL           string    `json:"svn_url"`
+		Homepage         *string   `json:"homepage"`
+		Size             int64     `json:"size"`
+		StargazersCount  int64     `json:"stargazers_count"`
+		WatchersCount    int64     `json:"watchers_count"`
+		Language         *string   `json:"language"`
+		HasIssues        bool      `json:"has_issues"`
+		HasDownloads     bool      `json:"has_downloads"`
+		HasWiki          bool      `json:"has_wiki"`
+		HasPages         bool      `json:"has_pages"`
+		ForksCount       int64     `json:"forks_count"`
+		MirrorURL        *string   `json:"mirror_url"`
+		OpenIssuesCount  int64     `json:"open_issues_count"`
+		Forks            int64     `json:"forks"`
+		OpenIssues       int64     `json:"open_issues"`
+		Watchers         int64     `json:"watchers"`
+		DefaultBranch    string    `json:"default_branch"`
+	} `json:"repository"`
+	Sender struct {
+		Login             string `json:"login"`
+		ID                int64  `json:"id"`
+		AvatarURL         string `json:"avatar_url"`
+		GravatarID        string `json:"gravatar_id"`
+		URL               string `json:"url"`
+		HTMLURL           string `json:"html_url"`
+		FollowersURL      string `json:"followers_url"`
+		FollowingURL      string `json:"following_url"`
+		GistsURL          string `json:"gists_url"`
+		StarredURL        string `json:"starred_url"`
+		SubscriptionsURL  string `json:"subscriptions_url"`
+		OrganizationsURL  string `json:"organizations_url"`
+		ReposURL          string `json:"repos_url"`
+		EventsURL         string `json:"events_url"`
+		ReceivedEventsURL string `json:"received_events_url"`
+		Type              string `json:"type"`
+		SiteAdmin         bool   `json:"site_admin"`
+	} `json:"sender"`
+	Installation Installation `json:"installation"`
+}
+
+// PullRequestPayload contains the information for GitHub's pull_request hook event
+type PullRequestPayload struct {
+	Action      string `json:"action"`
+	Number      int64  `json:"number"`
+	PullRequest struct {
+		URL      string `json:"url"`
+		ID       int64  `json:"id"`
+		HTMLURL  string `json:"html_url"`
+		DiffURL  string `json:"diff_url"`
+		PatchURL string `json:"patch_url"`
+		IssueURL string `json:"issue_url"`
+		Number   int64  `json:"number"`
+		State    string `json:"state"`
+		Locked   bool   `json:"locked"`
+		Title    string `json:"title"`
+		User     struct {
+			Login             string `json:"login"`
+			ID                int64  `json:"id"`
+			AvatarURL         string `json:"avatar_url"`
+			GravatarID        string `json:"gravatar_id"`
+			URL               string `json:"url"`
+			HTMLURL           string `json:"html_url"`
+			FollowersURL      string `json:"followers_url"`
+			FollowingURL      string `json:"following_url"`
+			GistsURL          string `json:"gists_url"`
+			StarredURL        string `json:"starred_url"`
+			SubscriptionsURL  string `json:"subscriptions_url"`
+			OrganizationsURL  string `json:"organizations_url"`
+			ReposURL          string `json:"repos_url"`
+			EventsURL         string `json:"events_url"`
+			ReceivedEventsURL string `json:"received_events_url"`
+			Type              string `json:"type"`
+			SiteAdmin         bool   `json:"site_admin"`
+		} `json:"user"`
+		Body               string     `json:"body"`
+		CreatedAt          time.Time  `json:"created_at"`
+		UpdatedAt          time.Time  `json:"updated_at"`
+		ClosedAt           *time.Time `json:"closed_at"`
+		MergedAt           *time.Time `json:"merged_at"`
+		MergeCommitSha     *string    `json:"merge_commit_sha"`
+		Assignee           *Assignee  `json:"assignee"`
+		Milestone          *Milestone `json:"milestone"`
+		CommitsURL         string     `json:"commits_url"`
+		ReviewCommentsURL  string     `json:"review_comments_url"`
+		ReviewCommentURL   string     `json:"review_comment_url"`
+		CommentsURL        string     `json:"comments_url"`
+		StatusesURL        string     `json:"statuses_url"`
+		RequestedReviewers []struct {
+			Login             string `json:"login"`
+			ID                int    `json:"id"`
+			AvatarURL         string `json:"avatar_url"`
+			GravatarID        string `json:"gravatar_id"`
+			URL               string `json:"url"`
+			HTMLURL           string `json:"html_url"`
+			FollowersURL      string `json:"followers_url"`
+			FollowingURL      string `json:"following_url"`
+			GistsURL          string `json:"gists_url"`
+			StarredURL        string `json:"starred_url"`
+			SubscriptionsURL  string `json:"subscriptions_url"`
+			OrganizationsURL  string `json:"organizations_url"`
+			ReposURL          string `json:"repos_url"`
+			EventsURL         string `json:"events_url"`
+			ReceivedEventsURL string `json:"received_events_url"`
+			Type              string `json:"type"`
+			SiteAdmin         bool   `json:"site_admin"`
+		} `json:"requested_reviewers,omitempty"`
+		Head struct {
+			Label string `json:"label"`
+			Ref   string `json:"ref"`
+			Sha   string `json:"sha"`
+			User  struct {
+				Login             string `json:"login"`
+				ID                int64  `json:"id"`
+				AvatarURL         string `json:"avatar_url"`
+				GravatarID        string `json:"gravatar_id"`
+				URL               string `json:"url"`
+				HTMLURL           string `json:"html_url"`
+				FollowersURL      string `json:"followers_url"`
+				FollowingURL      string `json:"following_url"`
+				GistsURL          string `json:"gists_url"`
+				StarredURL        string `json:"starred_url"`
+				SubscriptionsURL  string `json:"subscriptions_url"`
+				OrganizationsURL  string `json:"organizations_url"`
+				ReposURL          string `json:"repos_url"`
+				EventsURL         string `json:"events_url"`
+				ReceivedEventsURL string `json:"received_events_url"`
+				Type              string `json:"type"`
+				SiteAdmin         bool   `json:"site_admin"`
+			} `json:"user"`
+			Repo struct {
+				ID       int64  `json:"id"`
+				Name     string `json:"name"`
+				FullName string `json:"full_name"`
+				Owner    struct {
+					Login             string `json:"login"`
+					ID                int64  `json:"id"`
+					AvatarURL         string `json:"avatar_url"`
+					GravatarID        string `json:"gravatar_id"`
+					URL               string `json:"url"`
+					HTMLURL           string `json:"html_url"`
+					FollowersURL      string `json:"followers_url"`
+					FollowingURL      string `json:"following_url"`
+					GistsURL          string `json:"gists_url"`
+					StarredURL        string `json:"starred_url"`
+					SubscriptionsURL  string `json:"subscriptions_url"`
+					OrganizationsURL  string `json:"organizations_url"`
+					ReposURL          string `json:"repos_url"`
+					EventsURL         string `json:"events_url"`
+					ReceivedEventsURL string `json:"received_events_url"`
+					Type              string `json:"type"`
+					SiteAdmin         bool   `json:"site_admin"`
+				} `json:"owner"`
+				Private          bool      `json:"private"`
+				HTMLURL          string    `json:"html_url"`
+				Description      string    `json:"description"`
+				Fork             bool      `json:"fork"`
+				URL              string    `json:"url"`
+				ForksURL         string    `json:"forks_url"`
+				KeysURL          string    `json:"keys_url"`
+				CollaboratorsURL string    `json:"collaborators_url"`
+				TeamsURL         string    `json:"teams_url"`
+				HooksURL         string    `json:"hooks_url"`
+				IssueEventsURL   string    `json:"issue_events_url"`
+				EventsURL        string    `json:"events_url"`
+				AssigneesURL     string    `json:"assignees_url"`
+				BranchesURL      string    `json:"branches_url"`
+				TagsURL          string    `json:"tags_url"`
+				BlobsURL         string    `json:"blobs_url"`
+				GitTagsURL       string    `json:"git_tags_url"`
+				GitRefsURL       string    `json:"git_refs_url"`
+				TreesURL         string    `json:"trees_url"`
+				StatusesURL      string    `json:"statuses_url"`
+				LanguagesURL     string    `json:"languages_url"`
+				StargazersURL    string    `json:"stargazers_url"`
+				ContributorsURL  string    `json:"contributors_url"`
+				SubscribersURL   string    `json:"subscribers_url"`
+				SubscriptionURL  string    `json:"subscription_url"`
+				CommitsURL       string    `json:"commits_url"`
+				GitCommitsURL    string    `json:"git_commits_url"`
+				CommentsURL      string    `json:"comments_url"`
+				IssueCommentURL  string    `json:"issue_comment_url"`
+				ContentsURL      string    `json:"contents_url"`
+				CompareURL       string    `json:"compare_url"`
+				MergesURL        string    `json:"merges_url"`
+				ArchiveURL       string    `json:"archive_url"`
+				DownloadsURL     string    `json:"downloads_url"`
+				IssuesURL        string    `json:"issues_url"`
+				PullsURL         string    `json:"pulls_url"`
+				MilestonesURL    string    `json:"milestones_url"`
+				NotificationsURL string    `json:"notifications_url"`
+				LabelsURL        string    `json:"labels_url"`
+				ReleasesURL      string    `json:"releases_url"`
+				CreatedAt        time.Time `json:"created_at"`
+				UpdatedAt        time.Time `json:"updated_at"`
+				PushedAt         time.Time `json:"pushed_at"`
+				GitURL           string    `json:"git_url"`
+				SSHURL           string    `json:"ssh_url"`
+				CloneURL         string    `json:"clone_url"`
+				SvnURL           string    `json:"svn_url"`
+				Homepage         *string   `json:"homepage"`
+				Size             int64     `json:"size"`
+				StargazersCount  int64     `json:"stargazers_count"`
+				WatchersCount    int64     `json:"watchers_count"`
+				Language         *string   `json:"language"`
+				HasIssues        bool      `json:"has_issues"`
+				HasDownloads     bool      `json:"has_downloads"`
+				HasWiki          bool      `json:"has_wiki"`
+				HasPages         bool      `json:"has_pages"`
+				ForksCount       int64     `json:"forks_count"`
+				MirrorURL        *string   `json:"mirror_url"`
+				OpenIssuesCount  int64     `json:"open_issues_count"`
+				Forks            int64     `json:"forks"`
+				OpenIssues       int64     `json:"open_issues"`
+				Watchers         int64     `json:"watchers"`
+				DefaultBranch    string    `json:"default_branch"`
+			} `json:"repo"`
+		} `json:"head"`
+		Base struct {
+			Label string `json:"label"`
+			Ref   string `json:"ref"`
+			Sha   string `json:"sha"`
+			User  struct {
+				Login             string `json:"login"`
+				ID                int64  `json:"id"`
+				AvatarURL         string `json:"avatar_url"`
+				GravatarID        string `json:"gravatar_id"`
+				URL               string `json:"url"`
+				HTMLURL           string `json:"html_url"`
+				FollowersURL      string `json:"followers_url"`
+				FollowingURL      string `json:"following_url"`
+				GistsURL          string `json:"gists_url"`
+				StarredURL        string `json:"starred_url"`
+				SubscriptionsURL  string `json:"subscriptions_url"`
+				OrganizationsURL  string `json:"organizations_url"`
+				ReposURL          string `json:"repos_url"`
+				EventsURL         string `json:"events_url"`
+				ReceivedEventsURL string `json:"received_events_url"`
+				Type              string `json:"type"`
+				SiteAdmin         bool   `json:"site_admin"`
+			} `json:"user"`
+			Repo struct {
+				ID       int64  `json:"id"`
+				Name     string `json:"name"`
+				FullName string `json:"full_name"`
+				Owner    struct {
+					Login             string `json:"login"`
+					ID                int64  `json:"id"`
+					AvatarURL         string `json:"avatar_url"`
+					GravatarID        string `json:"gravatar_id"`
+					URL               string `json:"url"`
+					HTMLURL           string `json:"html_url"`
+					FollowersURL      string `json:"followers_url"`
+					FollowingURL      string `json:"following_url"`
+					GistsURL          string `json:"gists_url"`
+					StarredURL        string `json:"starred_url"`
+					SubscriptionsURL  string `json:"subscriptions_url"`
+					OrganizationsURL  string `json:"organizations_url"`
+					ReposURL          string `json:"repos_url"`
+					EventsURL         string `json:"events_url"`
+					ReceivedEventsURL string `json:"received_events_url"`
+					Type              string `json:"type"`
+					SiteAdmin         bool   `json:"site_admin"`
+				} `json:"owner"`
+				Private          bool      `json:"private"`
+				HTMLURL          string    `json:"html_url"`
+				Description      string    `json:"description"`
+				Fork             bool      `json:"fork"`
+				URL              string    `json:"url"`
+				ForksURL         string    `json:"forks_url"`
+				KeysURL          string    `json:"keys_url"`
+				CollaboratorsURL string    `json:"collaborators_url"`
+				TeamsURL         string    `json:"teams_url"`
+				HooksURL         string    `json:"hooks_url"`
+				IssueEventsURL   string    `json:"issue_events_url"`
+				EventsURL        string    `json:"events_url"`
+				AssigneesURL     string    `json:"assignees_url"`
+				BranchesURL      string    `json:"branches_url"`
+				TagsURL          string    `json:"tags_url"`
+				BlobsURL         string    `json:"blobs_url"`
+				GitTagsURL       string    `json:"git_tags_url"`
+				GitRefsURL       string    `json:"git_refs_url"`
+				TreesURL         string    `json:"trees_url"`
+				StatusesURL      string    `json:"statuses_url"`
+				LanguagesURL     string    `json:"languages_url"`
+				StargazersURL    string    `json:"stargazers_url"`
+				ContributorsURL  string    `json:"contributors_url"`
+				SubscribersURL   string    `json:"subscribers_url"`
+				SubscriptionURL  string    `json:"subscription_url"`
+				CommitsURL       string    `json:"commits_url"`
+				GitCommitsURL    string    `json:"git_commits_url"`
+				CommentsURL      string    `json:"comments_url"`
+				IssueCommentURL  string    `json:"issue_comment_url"`
+				ContentsURL      string    `json:"contents_url"`
+				CompareURL       string    `json:"compare_url"`
+				MergesURL        string    `json:"merges_url"`
+				ArchiveURL       string    `json:"archive_url"`
+				DownloadsURL     string    `json:"downloads_url"`
+				IssuesURL        string    `json:"issues_url"`
+				PullsURL         string    `json:"pulls_url"`
+				MilestonesURL    string    `json:"milestones_url"`
+				NotificationsURL string    `json:"notifications_url"`
+				LabelsURL        string    `json:"labels_url"`
+				ReleasesURL      string    `json:"releases_url"`
+				CreatedAt        time.Time `json:"created_at"`
+				UpdatedAt        time.Time `json:"updated_at"`
+				PushedAt         time.Time `json:"pushed_at"`
+				GitURL           string    `json:"git_url"`
+				SSHURL           string    `json:"ssh_url"`
+				CloneURL         string    `json:"clone_url"`
+				SvnURL           string    `json:"svn_url"`
+				Homepage         *string   `json:"homepage"`
+				Size             int64     `json:"size"`
+				StargazersCount  int64     `json:"stargazers_count"`
+				WatchersCount    int64     `json:"watchers_count"`
+				Language         *string   `json:"language"`
+				HasIssues        bool      `json:"has_issues"`
+				HasDownloads     bool      `json:"has_downloads"`
+				HasWiki          bool      `json:"has_wiki"`
+				HasPages         bool      `json:"has_pages"`
+				ForksCount       int64     `json:"forks_count"`
+				MirrorURL        *string   `json:"mirror_url"`
+				OpenIssuesCount  int64     `json:"open_issues_count"`
+				Forks            int64     `json:"forks"`
+				OpenIssues       int64     `json:"open_issues"`
+				Watchers         int64     `json:"watchers"`
+				DefaultBranch    string    `json:"default_branch"`
+			} `json:"repo"`
+		} `json:"base"`
+		Links struct {
+			Self struct {
+				Href string `json:"href"`
+			} `json:"self"`
+			HTML struct {
+				Href string `json:"href"`
+			} `json:"html"`
+			Issue struct {
+				Href string `json:"href"`
+			} `json:"issue"`
+			Comments struct {
+				Href string `json:"href"`
+			} `json:"comments"`
+			ReviewComments struct {
+				Href string `json:"href"`
+			} `json:"review_comments"`
+			ReviewComment struct {
+				Href string `json:"href"`
+			} `json:"review_comment"`
+			Commits struct {
+				Href string `json:"href"`
+			} `json:"commits"`
+			Statuses struct {
+				Href string `json:"href"`
+			} `json:"statuses"`
+		} `json:"_links"`
+		Merged         bool      `json:"merged"`
+		Mergeable      *bool     `json:"mergeable"`
+		MergeableState string    `json:"mergeable_state"`
+		MergedBy       *MergedBy `json:"merged_by"`
+		Comments       int64     `json:"comments"`
+		ReviewComments int64     `json:"review_comments"`
+		Commits        int64     `json:"commits"`
+		Additions      int64     `json:"additions"`
+		Deletions      int64     `json:"deletions"`
+		ChangedFiles   int64     `json:"changed_files"`
+	} `json:"pull_request"`
+	Label struct {
+		ID      int64  `json:"id"`
+		URL     string `json:"url"`
+		Name    string `json:"name"`
+		Color   string `json:"color"`
+		Default bool   `json:"default"`
+	} `json:"label"`
+	// Changes holds the previous values of the fields the edited action changed
+	Changes    *Changes `json:"changes,omitempty"`
+	Repository struct {
+		ID       int64  `json:"id"`
+		Name     string `json:"name"`
+		FullName string `json:"full_name"`
+		Owner    struct {
+			Login             string `json:"login"`
+			ID                int64  `json:"id"`
+			AvatarURL         string `json:"avatar_url"`
+			GravatarID        string `json:"gravatar_id"`
+			URL               string `json:"url"`
+			HTMLURL           string `json:"html_url"`
+			FollowersURL      string `json:"followers_url"`
+			FollowingURL      string `json:"following_url"`
+			GistsURL          string `json:"gists_url"`
+			StarredURL        string `json:"starred_url"`
+			SubscriptionsURL  string `json:"subscriptions_url"`
+			OrganizationsURL  string `json:"organizations_url"`
+			ReposURL          string `json:"repos_url"`
+			EventsURL         string `json:"events_url"`
+			ReceivedEventsURL string `json:"received_events_url"`
+			Type              string `json:"type"`
+			SiteAdmin         bool   `json:"site_admin"`
+		} `json:"owner"`
+		Private          bool      `json:"private"`
+		HTMLURL          string    `json:"html_url"`
+		Description      string    `json:"description"`
+		Fork             bool      `json:"fork"`
+		URL              string    `json:"url"`
+		ForksURL         string    `json:"forks_url"`
+		KeysURL          string    `json:"keys_url"`
+		CollaboratorsURL string    `json:"collaborators_url"`
+		TeamsURL         string    `json:"teams_url"`
+		HooksURL         string    `json:"hooks_url"`
+		IssueEventsURL   string    `json:"issue_events_url"`
+		EventsURL        string    `json:"events_url"`
+		AssigneesURL     string    `json:"assignees_url"`
+		BranchesURL      string    `json:"branches_url"`
+		TagsURL          string    `json:"tags_url"`
+		BlobsURL         string    `json:"blobs_url"`
+		GitTagsURL       string    `json:"git_tags_url"`
+		GitRefsURL       string    `json:"git_refs_url"`
+		TreesURL         string    `json:"trees_url"`
+		StatusesURL      string    `json:"statuses_url"`
+		LanguagesURL     string    `json:"languages_url"`
+		StargazersURL    string    `json:"stargazers_url"`
+		ContributorsURL  string    `json:"contributors_url"`
+		SubscribersURL   string    `json:"subscribers_url"`
+		SubscriptionURL  string    `json:"subscription_url"`
+		CommitsURL       string    `json:"commits_url"`
+		GitCommitsURL    string    `json:"git_commits_url"`
+		CommentsURL      string    `json:"comments_url"`
+		IssueCommentURL  string    `json:"issue_comment_url"`
+		ContentsURL      string    `json:"contents_url"`
+		CompareURL       string    `json:"compare_url"`
+		MergesURL        string    `json:"merges_url"`
+		ArchiveURL       string    `json:"archive_url"`
+		DownloadsURL     string    `json:"downloads_url"`
+		IssuesURL        string    `json:"issues_url"`
+		PullsURL         string    `json:"pulls_url"`
+		MilestonesURL    string    `json:"milestones_url"`
+		NotificationsURL string    `json:"notifications_url"`
+		LabelsURL        string    `json:"labels_url"`
+		ReleasesURL      string    `json:"releases_url"`
+		CreatedAt        time.Time `json:"created_at"`
+		UpdatedAt        time.Time `json:"updated_at"`
+		PushedAt         time.Time `json:"pushed_at"`
+		GitURL           string    `json:"git_url"`
+		SSHURL           string    `json:"ssh_url"`
+		CloneURL         string    `json:"clone_url"`
+		SvnURL           string    `json:"svn_url"`
+		Homepage         *string   `json:"homepage"`
+		Size             int64     `json:"size"`
+		StargazersCount  int64     `json:"stargazers_count"`
+		WatchersCount    int64     `json:"watchers_count"`
+		Language         *string   `json:"language"`
+		HasIssues        bool      `json:"has_issues"`
+		HasDownloads     bool      `json:"has_downloads"`
+		HasWiki          bool      `json:"has_wiki"`
+		HasPages         bool      `json:"has_pages"`
+		ForksCount       int64     `json:"forks_count"`
+		MirrorURL        *string   `json:"mirror_url"`
+		OpenIssuesCount  int64     `json:"open_issues_count"`
+		Forks            int64     `json:"forks"`
+		OpenIssues       int64     `json:"open_issues"`
+		Watchers         int64     `json:"watchers"`
+		DefaultBranch    string    `json:"default_branch"`
+	} `json:"repository"`
+	Sender struct {
+		Login             string `json:"login"`
+		ID                int64  `json:"id"`
+		AvatarURL         string `json:"avatar_url"`
+		GravatarID        string `json:"gravatar_id"`
+		URL               string `json:"url"`
+		HTMLURL           string `json:"html_url"`
+		FollowersURL      string `json:"followers_url"`
+		FollowingURL      string `json:"following_url"`
+		GistsURL          string `json:"gists_url"`
+		StarredURL        string `json:"starred_url"`
+		SubscriptionsURL  string `json:"subscriptions_url"`
+		OrganizationsURL  string `json:"organizations_url"`
+		ReposURL          string `json:"repos_url"`
+		EventsURL         string `json:"events_url"`
+		ReceivedEventsURL string `json:"received_events_url"`
+		Type              string `json:"type"`
+		SiteAdmin         bool   `json:"site_admin"`
+	} `json:"sender"`
+	Installation Installation `json:"installation"`
+}
+
+// PullRequestReviewPayload contains the information for GitHub's pull_request_review hook event
+type PullRequestReviewPayload struct {
+	Action string `json:"action"`
+	Review struct {
+		ID   int64 `json:"id"`
+		User struct {
+			Login             string `json:"login"`
+			ID                int64  `json:"id"`
+			AvatarURL         string `json:"avatar_url"`
+			GravatarID        string `json:"gravatar_id"`
+			URL               string `json:"url"`
+			HTMLURL           string `json:"html_url"`
+			FollowersURL      string `json:"followers_url"`
+			FollowingURL      string `json:"following_url"`
+			GistsURL          string `json:"gists_url"`
+			StarredURL        string `json:"starred_url"`
+			SubscriptionsURL  string `json:"subscriptions_url"`
+			OrganizationsURL  string `json:"organizations_url"`
+			ReposURL          string `json:"repos_url"`
+			EventsURL         string `json:"events_url"`
+			ReceivedEventsURL string `json:"received_events_url"`
+			Type              string `json:"type"`
+			SiteAdmin         bool   `json:"site_admin"`
+		} `json:"user"`
+		Body           string    `json:"body"`
+		SubmittedAt    time.Time `json:"submitted_at"`
+		State          string    `json:"state"`
+		HTMLURL        string    `json:"html_url"`
+		PullRequestURL string    `json:"pull_request_url"`
+		Links          struct {
+			HTML struct {
+				Href string `json:"href"`
+			} `json:"html"`
+			PullRequest struct {
+				Href string `json:"href"`
+			} `json:"pull_request"`
+		} `json:"_links"`
+	} `json:"review"`
+	PullRequest struct {
+		URL      string `json:"url"`
+		ID       int64  `json:"id"`
+		HTMLURL  string `json:"html_url"`
+		DiffURL  string `json:"diff_url"`
+		PatchURL string `json:"patch_url"`
+		IssueURL string `json:"issue_url"`
+		Number   int64  `json:"number"`
+		State    string `json:"state"`
+		Locked   bool   `json:"locked"`
+		Title    string `json:"title"`
+		User     struct {
+			Login             string `json:"login"`
+			ID                int64  `json:"id"`
+			AvatarURL         string `json:"avatar_url"`
+			GravatarID        string `json:"gravatar_id"`
+			URL               string `json:"url"`
+			HTMLURL           string `json:"html_url"`
+			FollowersURL      string `json:"followers_url"`
+			FollowingURL      string `json:"following_url"`
+			GistsURL          string `json:"gists_url"`
+			StarredURL        string `json:"starred_url"`
+			SubscriptionsURL  string `json:"subscriptions_url"`
+			OrganizationsURL  string `json:"organizations_url"`
+			ReposURL          string `json:"repos_url"`
+			EventsURL         string `json:"events_url"`
+			ReceivedEventsURL string `json:"received_events_url"`
+			Type              string `json:"type"`
+			SiteAdmin         bool   `json:"site_admin"`
+		} `json:"user"`
+		Body              string     `json:"body"`
+		CreatedAt         time.Time  `json:"created_at"`
+		UpdatedAt         time.Time  `json:"updated_at"`
+		ClosedAt          *time.Time `json:"closed_at"`
+		MergedAt          *time.Time `json:"merged_at"`
+		MergeCommitSha    string     `json:"merge_commit_sha"`
+		Assignee          *Assignee  `json:"assignee"`
+		Assignees         []Assignee `json:"assignees"`
+		Milestone         *Milestone `json:"milestone"`
+		CommitsURL        string     `json:"commits_url"`
+		ReviewCommentsURL string     `json:"review_comments_url"`
+		ReviewCommentURL  string     `json:"review_comment_url"`
+		CommentsURL       string     `json:"comments_url"`
+		StatusesURL       string     `json:"statuses_url"`
+		Head              struct {
+			Label string `json:"label"`
+			Ref   string `json:"ref"`
+			Sha   string `json:"sha"`
+			User  struct {
+				Login             string `json:"login"`
+				ID                int64  `json:"id"`
+				AvatarURL         string `json:"avatar_url"`
+				GravatarID        string `json:"gravatar_id"`
+				URL               string `json:"url"`
+				HTMLURL           string `json:"html_url"`
+				FollowersURL      string `json:"followers_url"`
+				FollowingURL      string `json:"following_url"`
+				GistsURL          string `json:"gists_url"`
+				StarredURL        string `json:"starred_url"`
+				SubscriptionsURL  string `json:"subscriptions_url"`
+				OrganizationsURL  string `json:"organizations_url"`
+				ReposURL          string `json:"repos_url"`
+				EventsURL         string `json:"events_url"`
+				ReceivedEventsURL string `json:"received_events_url"`
+				Type              string `json:"type"`
+				SiteAdmin         bool   `json:"site_admin"`
+			} `json:"user"`
+			Repo struct {
+				ID       int64  `json:"id"`
+				Name     string `json:"name"`
+				FullName string `json:"full_name"`
+				Owner    struct {
+					Login             string `json:"login"`
+					ID                int64  `json:"id"`
+					AvatarURL         string `json:"avatar_url"`
+					GravatarID        string `json:"gravatar_id"`
+					URL               string `json:"url"`
+					HTMLURL           string `json:"html_url"`
+					FollowersURL      string `json:"followers_url"`
+					FollowingURL      string `json:"following_url"`
+					GistsURL          string `json:"gists_url"`
+					StarredURL        string `json:"starred_url"`
+					SubscriptionsURL  string `json:"subscriptions_url"`
+					OrganizationsURL  string `json:"organizations_url"`
+					ReposURL          string `json:"repos_url"`
+					EventsURL         string `json:"events_url"`
+					ReceivedEventsURL string `json:"received_events_url"`
+					Type              string `json:"type"`
+					SiteAdmin         bool   `json:"site_admin"`
+				} `json:"owner"`
+				Private          bool      `json:"private"`
+				HTMLURL          string    `json:"html_url"`
+				Description      *string   `json:"description"`
+				Fork             bool      `json:"fork"`
+				URL              string    `json:"url"`
+				ForksURL         string    `json:"forks_url"`
+				KeysURL          string    `json:"keys_url"`
+				CollaboratorsURL string    `json:"collaborators_url"`
+				TeamsURL         string    `json:"teams_url"`
+				HooksURL         string    `json:"hooks_url"`
+				IssueEventsURL   string    `json:"issue_events_url"`
+				EventsURL        string    `json:"events_url"`
+				AssigneesURL     string    `json:"assignees_url"`
+				BranchesURL      string    `json:"branches_url"`
+				TagsURL          string    `json:"tags_url"`
+				BlobsURL         string    `json:"blobs_url"`
+				GitTagsURL       string    `json:"git_tags_url"`
+				GitRefsURL       string    `json:"git_refs_url"`
+				TreesURL         string    `json:"trees_url"`
+				StatusesURL      string    `json:"statuses_url"`
+				LanguagesURL     string    `json:"languages_url"`
+				StargazersURL    string    `json:"stargazers_url"`
+				ContributorsURL  string    `json:"contributors_url"`
+				SubscribersURL   string    `json:"subscribers_url"`
+				SubscriptionURL  string    `json:"subscription_url"`
+				CommitsURL       string    `json:"commits_url"`
+				GitCommitsURL    string    `json:"git_commits_url"`
+				CommentsURL      string    `json:"comments_url"`
+				IssueCommentURL  string    `json:"issue_comment_url"`
+				ContentsURL      string    `json:"contents_url"`
+				CompareURL       string    `json:"compare_url"`
+				MergesURL        string    `json:"merges_url"`
+				ArchiveURL       string    `json:"archive_url"`
+				DownloadsURL     string    `json:"downloads_url"`
+				IssuesURL        string    `json:"issues_url"`
+				PullsURL         string    `json:"pulls_url"`
+				MilestonesURL    string    `json:"milestones_url"`
+				NotificationsURL string    `json:"notifications_url"`
+				LabelsURL        string    `json:"labels_url"`
+				ReleasesURL      string    `json:"releases_url"`
+				DeploymentsURL   string    `json:"deployments_url"`
+				CreatedAt        time.Time `json:"created_at"`
+				UpdatedAt        time.Time `json:"updated_at"`
+				PushedAt         time.Time `json:"pushed_at"`
+				GitURL           string    `json:"git_url"`
+				SSHURL           string    `json:"ssh_url"`
+				CloneURL         string    `json:"clone_url"`
+				SvnURL           string    `json:"svn_url"`
+				Homepage         *string   `json:"homepage"`
+				Size             int64     `json:"size"`
+				StargazersCount  int64     `json:"stargazers_count"`
+				WatchersCount    int64     `json:"watchers_count"`
+				Language         *string   `json:"language"`
+				HasIssues        bool      `json:"has_issues"`
+				HasDownloads     bool      `json:"has_downloads"`
+				HasWiki          bool      `json:"has_wiki"`
+				HasPages         bool      `json:"has_pages"`
+				ForksCount       int64     `json:"forks_count"`
+				MirrorURL        *string   `json:"mirror_url"`
+				OpenIssuesCount  int64     `json:"open_issues_count"`
+				Forks            int64     `json:"forks"`
+				OpenIssues       int64     `json:"open_issues"`
+				Watchers         int64     `json:"watchers"`
+				DefaultBranch    string    `json:"default_branch"`
+			} `json:"repo"`
+		} `json:"head"`
+		Base struct {
+			Label string `json:"label"`
+			Ref   string `json:"ref"`
+			Sha   string `json:"sha"`
+			User  struct {
+				Login             string `json:"login"`
+				ID                int64  `json:"id"`
+				AvatarURL         string `json:"avatar_url"`
+				GravatarID        string `json:"gravatar_id"`
+				URL               string `json:"url"`
+				HTMLURL           string `json:"html_url"`
+				FollowersURL      string `json:"followers_url"`
+				FollowingURL      string `json:"following_url"`
+				GistsURL          string `json:"gists_url"`
+				StarredURL        string `json:"starred_url"`
+				SubscriptionsURL  string `json:"subscriptions_url"`
+				OrganizationsURL  string `json:"organizations_url"`
+				ReposURL          string `json:"repos_url"`
+				EventsURL         string `json:"events_url"`
+				ReceivedEventsURL string `json:"received_events_url"`
+				Type              string `json:"type"`
+				SiteAdmin         bool   `json:"site_admin"`
+			} `json:"user"`
+			Repo struct {
+				ID       int64  `json:"id"`
+				Name     string `json:"name"`
+				FullName string `json:"full_name"`
+				Owner    struct {
+					Login             string `json:"login"`
+					ID                int64  `json:"id"`
+					AvatarURL         string `json:"avatar_url"`
+					GravatarID        string `json:"gravatar_id"`
+					URL               string `json:"url"`
+					HTMLURL           string `json:"html_url"`
+					FollowersURL      string `json:"followers_url"`
+					FollowingURL      string `json:"following_url"`
+					GistsURL          string `json:"gists_url"`
+					StarredURL        string `json:"starred_url"`
+					SubscriptionsURL  string `json:"subscriptions_url"`
+					OrganizationsURL  string `json:"organizations_url"`
+					ReposURL          string `json:"repos_url"`
+					EventsURL         string `json:"events_url"`
+					ReceivedEventsURL string `json:"received_events_url"`
+					Type              string `json:"type"`
+					SiteAdmin         bool   `json:"site_admin"`
+				} `json:"owner"`
+				Private          bool      `json:"private"`
+				HTMLURL          string    `json:"html_url"`
+				Description      string    `json:"description"`
+				Fork             bool      `json:"fork"`
+				URL              string    `json:"url"`
+				ForksURL         string    `json:"forks_url"`
+				KeysURL          string    `json:"keys_url"`
+				CollaboratorsURL string    `json:"collaborators_url"`
+				TeamsURL         string    `json:"teams_url"`
+				HooksURL         string    `json:"hooks_url"`
+				IssueEventsURL   string    `json:"issue_events_url"`
+				EventsURL        string    `json:"events_url"`
+				AssigneesURL     string    `json:"assignees_url"`
+				BranchesURL      string    `json:"branches_url"`
+				TagsURL          string    `json:"tags_url"`
+				BlobsURL         string    `json:"blobs_url"`
+				GitTagsURL       string    `json:"git_tags_url"`
+				GitRefsURL       string    `json:"git_refs_url"`
+				TreesURL         string    `json:"trees_url"`
+				StatusesURL      string    `json:"statuses_url"`
+				LanguagesURL     string    `json:"languages_url"`
+				StargazersURL    string    `json:"stargazers_url"`
+				ContributorsURL  string    `json:"contributors_url"`
+				SubscribersURL   string    `json:"subscribers_url"`
+				SubscriptionURL  string    `json:"subscription_url"`
+				CommitsURL       string    `json:"commits_url"`
+				GitCommitsURL    string    `json:"git_commits_url"`
+				CommentsURL      string    `json:"comments_url"`
+				IssueCommentURL  string    `json:"issue_comment_url"`
+				ContentsURL      string    `json:"contents_url"`
+				CompareURL       string    `json:"compare_url"`
+				MergesURL        string    `json:"merges_url"`
+				ArchiveURL       string    `json:"archive_url"`
+				DownloadsURL     string    `json:"downloads_url"`
+				IssuesURL        string    `json:"issues_url"`
+				PullsURL         string    `json:"pulls_url"`
+				MilestonesURL    string    `json:"milestones_url"`
+				NotificationsURL string    `json:"notifications_url"`
+				LabelsURL        string    `json:"labels_url"`
+				ReleasesURL      string    `json:"releases_url"`
+				DeploymentsURL   string    `json:"deployments_url"`
+				CreatedAt        time.Time `json:"created_at"`
+				UpdatedAt        time.Time `json:"updated_at"`
+				PushedAt         time.Time `json:"pushed_at"`
+				GitURL           string    `json:"git_url"`
+				SSHURL           string    `json:"ssh_url"`
+				CloneURL         string    `json:"clone_url"`
+				SvnURL           string    `json:"svn_url"`
+				Homepage         *string   `json:"homepage"`
+				Size             int64     `json:"size"`
+				StargazersCount  int64     `json:"stargazers_count"`
+				WatchersCount    int64     `json:"watchers_count"`
+				Language         *string   `json:"language"`
+				HasIssues        bool      `json:"has_issues"`
+				HasDownloads     bool      `json:"has_downloads"`
+				HasWiki          bool      `json:"has_wiki"`
+				HasPages         bool      `json:"has_pages"`
+				ForksCount       int64     `json:"forks_count"`
+				MirrorURL        *string   `json:"mirror_url"`
+				OpenIssuesCount  int64     `json:"open_issues_count"`
+				Forks            int64     `json:"forks"`
+				OpenIssues       int64     `json:"open_issues"`
+				Watchers         int64     `json:"watchers"`
+				DefaultBranch    string    `json:"default_branch"`
+			} `json:"repo"`
+		} `json:"base"`
+		Links struct {
+			Self struct {
+				Href string `json:"href"`
+			} `json:"self"`
+			HTML struct {
+				Href string `json:"href"`
+			} `json:"html"`
+			Issue struct {
+				Href string `json:"href"`
+			} `json:"issue"`
+			Comments struct {
+				Href string `json:"href"`
+			} `json:"comments"`
+			ReviewComments struct {
+				Href string `json:"href"`
+			} `json:"review_comments"`
+			ReviewComment struct {
+				Href string `json:"href"`
+			} `json:"review_comment"`
+			Commits struct {
+				Href string `json:"href"`
+			} `json:"commits"`
+			Statuses struct {
+				Href string `json:"href"`
+			} `json:"statuses"`
+		} `json:"_links"`
+	} `json:"pull_request"`
+	Repository struct {
+		ID       int64  `json:"id"`
+		Name     string `json:"name"`
+		FullName string `json:"full_name"`
+		Owner    struct {
+			Login             string `json:"login"`
+			ID                int64  `json:"id"`
+			AvatarURL         string `json:"avatar_url"`
+			GravatarID        string `json:"gravatar_id"`
+			URL               string `json:"url"`
+			HTMLURL           string `json:"html_url"`
+			FollowersURL      string `json:"followers_url"`
+			FollowingURL      string `json:"following_url"`
+			GistsURL          string `json:"gists_url"`
+			StarredURL        string `json:"starred_url"`
+			SubscriptionsURL  string `json:"subscriptions_url"`
+			OrganizationsURL  string `json:"organizations_url"`
+			ReposURL          string `json:"repos_url"`
+			EventsURL         string `json:"events_url"`
+			ReceivedEventsURL string `json:"received_events_url"`
+			Type              string `json:"type"`
+			SiteAdmin         bool   `json:"site_admin"`
+		} `json:"owner"`
+		Private          bool      `json:"private"`
+		HTMLURL          string    `json:"html_url"`
+		Description      string    `json:"description"`
+		Fork             bool      `json:"fork"`
+		URL              string    `json:"url"`
+		ForksURL         string    `json:"forks_url"`
+		KeysURL          string    `json:"keys_url"`
+		CollaboratorsURL string    `json:"collaborators_url"`
+		TeamsURL         string    `json:"teams_url"`
+		HooksURL         string    `json:"hooks_url"`
+		IssueEventsURL   string    `json:"issue_events_url"`
+		EventsURL        string    `json:"events_url"`
+		AssigneesURL     string    `json:"assignees_url"`
+		BranchesURL      string    `json:"branches_url"`
+		TagsURL          string    `json:"tags_url"`
+		BlobsURL         string    `json:"blobs_url"`
+		GitTagsURL       string    `json:"git_tags_url"`
+		GitRefsURL       string    `json:"git_refs_url"`
+		TreesURL         string    `json:"trees_url"`
+		StatusesURL      string    `json:"statuses_url"`
+		LanguagesURL     string    `json:"languages_url"`
+		StargazersURL    string    `json:"stargazers_url"`
+		ContributorsURL  string    `json:"contributors_url"`
+		SubscribersURL   string    `json:"subscribers_url"`
+		SubscriptionURL  string    `json:"subscription_url"`
+		CommitsURL       string    `json:"commits_url"`
+		GitCommitsURL    string    `json:"git_commits_url"`
+		CommentsURL      string    `json:"comments_url"`
+		IssueCommentURL  string    `json:"issue_comment_url"`
+		ContentsURL      string    `json:"contents_url"`
+		CompareURL       string    `json:"compare_url"`
+		MergesURL        string    `json:"merges_url"`
+		ArchiveURL       string    `json:"archive_url"`
+		DownloadsURL     string    `json:"downloads_url"`
+		IssuesURL        string    `json:"issues_url"`
+		PullsURL         string    `json:"pulls_url"`
+		MilestonesURL    string    `json:"milestones_url"`
+		NotificationsURL string    `json:"notifications_url"`
+		LabelsURL        string    `json:"labels_url"`
+		ReleasesURL      string    `json:"releases_url"`
+		DeploymentsURL   string    `json:"deployments_url"`
+		CreatedAt        time.Time `json:"created_at"`
+		UpdatedAt        time.Time `json:"updated_at"`
+		PushedAt         time.Time `json:"pushed_at"`
+		GitURL           string    `json:"git_url"`
+		SSHURL           string    `json:"ssh_url"`
+		CloneURL         string    `json:"clone_url"`
+		SvnURL           string    `json:"svn_url"`
+		Homepage         *string   `json:"homepage"`
+		Size             int64     `json:"size"`
+		StargazersCount  int64     `json:"stargazers_count"`
+		WatchersCount    int64     `json:"watchers_count"`
+		Language         *string   `json:"language"`
+		HasIssues        bool      `json:"has_issues"`
+		HasDownloads     bool      `json:"has_downloads"`
+		HasWiki          bool      `json:"has_wiki"`
+		HasPages         bool      `json:"has_pages"`
+		ForksCount       int64     `json:"forks_count"`
+		MirrorURL        *string   `json:"mirror_url"`
+		OpenIssuesCount  int64     `json:"open_issues_count"`
+		Forks            int64     `json:"forks"`
+		OpenIssues       int64     `json:"open_issues"`
+		Watchers         int64     `json:"watchers"`
+		DefaultBranch    string    `json:"default_branch"`
+	} `json:"repository"`
+	Sender struct {
+		Login             string `json:"login"`
+		ID                int64  `json:"id"`
+		AvatarURL         string `json:"avatar_url"`
+		GravatarID        string `json:"gravatar_id"`
+		URL               string `json:"url"`
+		HTMLURL           string `json:"html_url"`
+		FollowersURL      string `json:"followers_url"`
+		FollowingURL      string `json:"following_url"`
+		GistsURL          string `json:"gists_url"`
+		StarredURL        string `json:"starred_url"`
+		SubscriptionsURL  string `json:"subscriptions_url"`
+		OrganizationsURL  string `json:"organizations_url"`
+		ReposURL          string `json:"repos_url"`
+		EventsURL         string `json:"events_url"`
+		ReceivedEventsURL string `json:"received_events_url"`
+		Type              string `json:"type"`
+		SiteAdmin         bool   `json:"site_admin"`
+	} `json:"sender"`
+	Installation Installation `json:"installation"`
+}
+
+// PullRequestReviewCommentPayload contains the information for GitHub's pull_request_review_comments hook event
+type PullRequestReviewCommentPayload struct {
+	Action  string `json:"action"`
+	Comment struct {
+		URL              string `json:"url"`
+		ID               int64  `json:"id"`
+		DiffHunk         string `json:"diff_hunk"`
+		Path             string `json:"path"`
+		Position         int64  `json:"position"`
+		OriginalPosition int64  `json:"original_position"`
+		CommitID         string `json:"commit_id"`
+		OriginalCommitID string `json:"original_commit_id"`
+		User             struct {
+			Login             string `json:"login"`
+			ID                int64  `json:"id"`
+			AvatarURL         string `json:"avatar_url"`
+			GravatarID        string `json:"gravatar_id"`
+			URL               string `json:"url"`
+			HTMLURL           string `json:"html_url"`
+			FollowersURL      string `json:"followers_url"`
+			FollowingURL      string `json:"following_url"`
+			GistsURL          string `json:"gists_url"`
+			StarredURL        string `json:"starred_url"`
+			SubscriptionsURL  string `json:"subscriptions_url"`
+			OrganizationsURL  string `json:"organizations_url"`
+			ReposURL          string `json:"repos_url"`
+			EventsURL         string `json:"events_url"`
+			ReceivedEventsURL string `json:"received_events_url"`
+			Type              string `json:"type"`
+			SiteAdmin         bool   `json:"site_admin"`
+		} `json:"user"`
+		Body              string    `json:"body"`
+		AuthorAssociation string    `json:"author_association"`
+		CreatedAt         time.Time `json:"created_at"`
+		UpdatedAt         time.Time `json:"updated_at"`
+		HTMLURL           string    `json:"html_url"`
+		PullRequestURL    string    `json:"pull_request_url"`
+		Links             struct {
+			Self struct {
+				Href string `json:"href"`
+			} `json:"self"`
+			HTML struct {
+				Href string `json:"href"`
+			} `json:"html"`
+			PullRequest struct {
+				Href string `json:"href"`
+			} `json:"pull_request"`
+		} `json:"_links"`
+	} `json:"comment"`
+	PullRequest struct {
+		URL      string `json:"url"`
+		ID       int64  `json:"id"`
+		HTMLURL  string `json:"html_url"`
+		DiffURL  string `json:"diff_url"`
+		PatchURL string `json:"patch_url"`
+		IssueURL string `json:"issue_url"`
+		Number   int64  `json:"number"`
+		State    string `json:"state"`
+		Locked   bool   `json:"locked"`
+		Title    string `json:"title"`
+		User     struct {
+			Login             string `json:"login"`
+			ID                int64  `json:"id"`
+			AvatarURL         string `json:"avatar_url"`
+			GravatarID        string `json:"gravatar_id"`
+			URL               string `json:"url"`
+			HTMLURL           string `json:"html_url"`
+			FollowersURL      string `json:"followers_url"`
+			FollowingURL      string `json:"following_url"`
+			GistsURL          string `json:"gists_url"`
+			StarredURL        string `json:"starred_url"`
+			SubscriptionsURL  string `json:"subscriptions_url"`
+			OrganizationsURL  string `json:"organizations_url"`
+			ReposURL          string `json:"repos_url"`
+			EventsURL         string `json:"events_url"`
+			ReceivedEventsURL string `json:"received_events_url"`
+			Type              string `json:"type"`
+			SiteAdmin         bool   `json:"site_admin"`
+		} `json:"user"`
+		Body              string     `json:"body"`
+		CreatedAt         time.Time  `json:"created_at"`
+		UpdatedAt         time.Time  `json:"updated_at"`
+		ClosedAt          *time.Time `json:"closed_at"`
+		MergedAt          *time.Time `json:"merged_at"`
+		MergeCommitSha    string     `json:"merge_commit_sha"`
+		Assignee          *Assignee  `json:"assignee"`
+		Milestone         *Milestone `json:"milestone"`
+		CommitsURL        string     `json:"commits_url"`
+		ReviewCommentsURL string     `json:"review_comments_url"`
+		ReviewCommentURL  string     `json:"review_comment_url"`
+		CommentsURL       string     `json:"comments_url"`
+		StatusesURL       string     `json:"statuses_url"`
+		Head              struct {
+			Label string `json:"label"`
+			Ref   string `json:"ref"`
+			Sha   string `json:"sha"`
+			User  struct {
+				Login             string `json:"login"`
+				ID                int64  `json:"id"`
+				AvatarURL         string `json:"avatar_url"`
+				GravatarID        string `json:"gravatar_id"`
+				URL               string `json:"url"`
+				HTMLURL           string `json:"html_url"`
+				FollowersURL      string `json:"followers_url"`
+				FollowingURL      string `json:"following_url"`
+				GistsURL          string `json:"gists_url"`
+				StarredURL        string `json:"starred_url"`
+				SubscriptionsURL  string `json:"subscriptions_url"`
+				OrganizationsURL  string `json:"organizations_url"`
+				ReposURL          string `json:"repos_url"`
+				EventsURL         string `json:"events_url"`
+				ReceivedEventsURL string `json:"received_events_url"`
+				Type              string `json:"type"`
+				SiteAdmin         bool   `json:"site_admin"`
+			} `json:"user"`
+			Repo struct {
+				ID       int64  `json:"id"`
+				Name     string `json:"name"`
 				FullName string `json:"full_name"`
 				Owner    struct {
 					Login             string `json:"login"`
@@ -3298,7 +5459,7 @@ type PullRequestReviewPayload struct {
 				} `json:"owner"`
 				Private          bool      `json:"private"`
 				HTMLURL          string    `json:"html_url"`
-				Description      *string   `json:"description"`
+				Description      string    `json:"description"`
 				Fork             bool      `json:"fork"`
 				URL              string    `json:"url"`
 				ForksURL         string    `json:"forks_url"`
@@ -3336,7 +5497,6 @@ type PullRequestReviewPayload struct {
 				NotificationsURL string    `json:"notifications_url"`
 				LabelsURL        string    `json:"labels_url"`
 				ReleasesURL      string    `json:"releases_url"`
-				DeploymentsURL   string    `json:"deployments_url"`
 				CreatedAt        time.Time `json:"created_at"`
 				UpdatedAt        time.Time `json:"updated_at"`
 				PushedAt         time.Time `json:"pushed_at"`
@@ -3448,7 +5608,6 @@ type PullRequestReviewPayload struct {
 				NotificationsURL string    `json:"notifications_url"`
 				LabelsURL        string    `json:"labels_url"`
 				ReleasesURL      string    `json:"releases_url"`
-				DeploymentsURL   string    `json:"deployments_url"`
 				CreatedAt        time.Time `json:"created_at"`
 				UpdatedAt        time.Time `json:"updated_at"`
 				PushedAt         time.Time `json:"pushed_at"`
@@ -3564,7 +5723,6 @@ type PullRequestReviewPayload struct {
 		NotificationsURL string    `json:"notifications_url"`
 		LabelsURL        string    `json:"labels_url"`
 		ReleasesURL      string    `json:"releases_url"`
-		DeploymentsURL   string    `json:"deployments_url"`
 		CreatedAt        time.Time `json:"created_at"`
 		UpdatedAt        time.Time `json:"updated_at"`
 		PushedAt         time.Time `json:"pushed_at"`
@@ -3608,57 +5766,61 @@ type PullRequestReviewPayload struct {
 		Type              string `json:"type"`
 		SiteAdmin         bool   `json:"site_admin"`
 	} `json:"sender"`
+	Installation Installation `json:"installation"`
 }
 
-// PullRequestReviewCommentPayload contains the information for GitHub's pull_request_review_comments hook event
-type PullRequestReviewCommentPayload struct {
-	Action  string `json:"action"`
-	Comment struct {
-		URL              string `json:"url"`
-		ID               int64  `json:"id"`
-		DiffHunk         string `json:"diff_hunk"`
-		Path             string `json:"path"`
-		Position         int64  `json:"position"`
-		OriginalPosition int64  `json:"original_position"`
-		CommitID         string `json:"commit_id"`
-		OriginalCommitID string `json:"original_commit_id"`
-		User             struct {
-			Login             string `json:"login"`
-			ID                int64  `json:"id"`
-			AvatarURL         string `json:"avatar_url"`
-			GravatarID        string `json:"gravatar_id"`
-			URL               string `json:"url"`
-			HTMLURL           string `json:"html_url"`
-			FollowersURL      string `json:"followers_url"`
-			FollowingURL      string `json:"following_url"`
-			GistsURL          string `json:"gists_url"`
-			StarredURL        string `json:"starred_url"`
-			SubscriptionsURL  string `json:"subscriptions_url"`
-			OrganizationsURL  string `json:"organizations_url"`
-			ReposURL          string `json:"repos_url"`
-			EventsURL         string `json:"events_url"`
-			ReceivedEventsURL string `json:"received_events_url"`
-			Type              string `json:"type"`
-			SiteAdmin         bool   `json:"site_admin"`
-		} `json:"user"`
-		Body              string    `json:"body"`
-		AuthorAssociation string    `json:"author_association"`
-		CreatedAt         time.Time `json:"created_at"`
-		UpdatedAt         time.Time `json:"updated_at"`
-		HTMLURL           string    `json:"html_url"`
-		PullRequestURL    string    `json:"pull_request_url"`
-		Links             struct {
-			Self struct {
-				Href string `json:"href"`
-			} `json:"self"`
-			HTML struct {
-				Href string `json:"href"`
-			} `json:"html"`
-			PullRequest struct {
-				Href string `json:"href"`
-			} `json:"pull_request"`
-		} `json:"_links"`
-	} `json:"comment"`
+// PullRequestReviewThreadPayload contains the information for GitHub's pull_request_review_thread hook event
+type PullRequestReviewThreadPayload struct {
+	Action string `json:"action"`
+	Thread struct {
+		NodeID   string `json:"node_id"`
+		Comments []struct {
+			URL              string `json:"url"`
+			ID               int64  `json:"id"`
+			DiffHunk         string `json:"diff_hunk"`
+			Path             string `json:"path"`
+			Position         int64  `json:"position"`
+			OriginalPosition int64  `json:"original_position"`
+			CommitID         string `json:"commit_id"`
+			OriginalCommitID string `json:"original_commit_id"`
+			User             struct {
+				Login             string `json:"login"`
+				ID                int64  `json:"id"`
+				AvatarURL         string `json:"avatar_url"`
+				GravatarID        string `json:"gravatar_id"`
+				URL               string `json:"url"`
+				HTMLURL           string `json:"html_url"`
+				FollowersURL      string `json:"followers_url"`
+				FollowingURL      string `json:"following_url"`
+				GistsURL          string `json:"gists_url"`
+				StarredURL        string `json:"starred_url"`
+				SubscriptionsURL  string `json:"subscriptions_url"`
+				OrganizationsURL  string `json:"organizations_url"`
+				ReposURL          string `json:"repos_url"`
+				EventsURL         string `json:"events_url"`
+				ReceivedEventsURL string `json:"received_events_url"`
+				Type              string `json:"type"`
+				SiteAdmin         bool   `json:"site_admin"`
+			} `json:"user"`
+			Body              string    `json:"body"`
+			AuthorAssociation string    `json:"author_association"`
+			CreatedAt         time.Time `json:"created_at"`
+			UpdatedAt         time.Time `json:"updated_at"`
+			HTMLURL           string    `json:"html_url"`
+			PullRequestURL    string    `json:"pull_request_url"`
+			Links             struct {
+				Self struct {
+					Href string `json:"href"`
+				} `json:"self"`
+				HTML struct {
+					Href string `json:"href"`
+				} `json:"html"`
+				PullRequest struct {
+					Href string `json:"href"`
+				} `json:"pull_request"`
+			} `json:"_links"`
+		} `json:"comments"`
+	} `json:"thread"`
 	PullRequest struct {
 		URL      string `json:"url"`
 		ID       int64  `json:"id"`
@@ -4057,68 +6219,1132 @@ type PullRequestReviewCommentPayload struct {
 		Type              string `json:"type"`
 		SiteAdmin         bool   `json:"site_admin"`
 	} `json:"sender"`
+	Installation Installation `json:"installation"`
+}
+
+// PushPayload contains the information for GitHub's push hook event
+type PushPayload struct {
+	Ref     string  `json:"ref"`
+	Before  string  `json:"before"`
+	After   string  `json:"after"`
+	Created bool    `json:"created"`
+	Deleted bool    `json:"deleted"`
+	Forced  bool    `json:"forced"`
+	BaseRef *string `json:"base_ref"`
+	Compare string  `json:"compare"`
+	Commits []struct {
+		Sha       string `json:"sha"`
+		ID        string `json:"id"`
+		TreeID    string `json:"tree_id"`
+		Distinct  bool   `json:"distinct"`
+		Message   string `json:"message"`
+		Timestamp string `json:"timestamp"`
+		URL       string `json:"url"`
+		Author    struct {
+			Name     string `json:"name"`
+			Email    string `json:"email"`
+			Username string `json:"username"`
+		} `json:"author"`
+		Committer struct {
+			Name     string `json:"name"`
+			Email    string `json:"email"`
+			Username string `json:"username"`
+		} `json:"committer"`
+		Added    []string `json:"added"`
+		Removed  []string `json:"removed"`
+		Modified []string `json:"modified"`
+	} `json:"commits"`
+	HeadCommit struct {
+		ID        string `json:"id"`
+		TreeID    string `json:"tree_id"`
+		Distinct  bool   `json:"distinct"`
+		Message   string `json:"message"`
+		Timestamp string `json:"timestamp"`
+		URL       string `json:"url"`
+		Author    struct {
+			Name     string `json:"name"`
+			Email    string `json:"email"`
+			Username string `json:"username"`
+		} `json:"author"`
+		Committer struct {
+			Name     string `json:"name"`
+			Email    string `json:"email"`
+			Username string `json:"username"`
+		} `json:"committer"`
+		Added    []string `json:"added"`
+		Removed  []string `json:"removed"`
+		Modified []string `json:"modified"`
+	} `json:"head_commit"`
+	Repository struct {
+		ID       int64  `json:"id"`
+		Name     string `json:"name"`
+		FullName string `json:"full_name"`
+		Owner    struct {
+			Name  string `json:"name"`
+			Email string `json:"email"`
+		} `json:"owner"`
+		Private          bool      `json:"private"`
+		HTMLURL          string    `json:"html_url"`
+		Description      string    `json:"description"`
+		Fork             bool      `json:"fork"`
+		URL              string    `json:"url"`
+		ForksURL         string    `json:"forks_url"`
+		KeysURL          string    `json:"keys_url"`
+		CollaboratorsURL string    `json:"collaborators_url"`
+		TeamsURL         string    `json:"teams_url"`
+		HooksURL         string    `json:"hooks_url"`
+		IssueEventsURL   string    `json:"issue_events_url"`
+		EventsURL        string    `json:"events_url"`
+		AssigneesURL     string    `json:"assignees_url"`
+		BranchesURL      string    `json:"branches_url"`
+		TagsURL          string    `json:"tags_url"`
+		BlobsURL         string    `json:"blobs_url"`
+		GitTagsURL       string    `json:"git_tags_url"`
+		GitRefsURL       string    `json:"git_refs_url"`
+		TreesURL         string    `json:"trees_url"`
+		StatusesURL      string    `json:"statuses_url"`
+		LanguagesURL     string    `json:"languages_url"`
+		StargazersURL    string    `json:"stargazers_url"`
+		ContributorsURL  string    `json:"contributors_url"`
+		SubscribersURL   string    `json:"subscribers_url"`
+		SubscriptionURL  string    `json:"subscription_url"`
+		CommitsURL       string    `json:"commits_url"`
+		GitCommitsURL    string    `json:"git_commits_url"`
+		CommentsURL      string    `json:"comments_url"`
+		IssueCommentURL  string    `json:"issue_comment_url"`
+		ContentsURL      string    `json:"contents_url"`
+		CompareURL       string    `json:"compare_url"`
+		MergesURL        string    `json:"merges_url"`
+		ArchiveURL       string    `json:"archive_url"`
+		DownloadsURL     string    `json:"downloads_url"`
+		IssuesURL        string    `json:"issues_url"`
+		PullsURL         string    `json:"pulls_url"`
+		MilestonesURL    string    `json:"milestones_url"`
+		NotificationsURL string    `json:"notifications_url"`
+		LabelsURL        string    `json:"labels_url"`
+		ReleasesURL      string    `json:"releases_url"`
+		CreatedAt        int64     `json:"created_at"`
+		UpdatedAt        time.Time `json:"updated_at"`
+		PushedAt         int64     `json:"pushed_at"`
+		GitURL           string    `json:"git_url"`
+		SSHURL           string    `json:"ssh_url"`
+		CloneURL         string    `json:"clone_url"`
+		SvnURL           string    `json:"svn_url"`
+		Homepage         *string   `json:"homepage"`
+		Size             int64     `json:"size"`
+		StargazersCount  int64     `json:"stargazers_count"`
+		WatchersCount    int64     `json:"watchers_count"`
+		Language         *string   `json:"language"`
+		HasIssues        bool      `json:"has_issues"`
+		HasDownloads     bool      `json:"has_downloads"`
+		HasWiki          bool      `json:"has_wiki"`
+		HasPages         bool      `json:"has_pages"`
+		ForksCount       int64     `json:"forks_count"`
+		MirrorURL        *string   `json:"mirror_url"`
+		OpenIssuesCount  int64     `json:"open_issues_count"`
+		Forks            int64     `json:"forks"`
+		OpenIssues       int64     `json:"open_issues"`
+		Watchers         int64     `json:"watchers"`
+		DefaultBranch    string    `json:"default_branch"`
+		Stargazers       int64     `json:"stargazers"`
+		MasterBranch     string    `json:"master_branch"`
+	} `json:"repository"`
+	Pusher struct {
+		Name  string `json:"name"`
+		Email string `json:"email"`
+	} `json:"pusher"`
+	Sender struct {
+		Login             string `json:"login"`
+		ID                int64  `json:"id"`
+		AvatarURL         string `json:"avatar_url"`
+		GravatarID        string `json:"gravatar_id"`
+		URL               string `json:"url"`
+		HTMLURL           string `json:"html_url"`
+		FollowersURL      string `json:"followers_url"`
+		FollowingURL      string `json:"following_url"`
+		GistsURL          string `json:"gists_url"`
+		StarredURL        string `json:"starred_url"`
+		SubscriptionsURL  string `json:"subscriptions_url"`
+		OrganizationsURL  string `json:"organizations_url"`
+		ReposURL          string `json:"repos_url"`
+		EventsURL         string `json:"events_url"`
+		ReceivedEventsURL string `json:"received_events_url"`
+		Type              string `json:"type"`
+		SiteAdmin         bool   `json:"site_admin"`
+	} `json:"sender"`
+	Installation Installation `json:"installation"`
+}
+
+// LazyPushPayload is decoded in place of PushPayload when lazy decoding is enabled,
+// keeping the commits raw until DecodeCommits is called
+type LazyPushPayload struct {
+	PushPayload
+	Commits json.RawMessage `json:"commits"`
+}
+
+// DecodeCommits decodes the raw commits into PushPayload.Commits
+func (p *LazyPushPayload) DecodeCommits() error {
+	if len(p.Commits) == 0 {
+		return nil
+	}
+	return json.Unmarshal(p.Commits, &p.PushPayload.Commits)
+}
+
+// ReleasePayload contains the information for GitHub's release hook event
+type ReleasePayload struct {
+	Action  string `json:"action"`
+	Release struct {
+		URL             string  `json:"url"`
+		AssetsURL       string  `json:"assets_url"`
+		UploadURL       string  `json:"upload_url"`
+		HTMLURL         string  `json:"html_url"`
+		ID              int64   `json:"id"`
+		TagName         string  `json:"tag_name"`
+		TargetCommitish string  `json:"target_commitish"`
+		Name            *string `json:"name"`
+		Draft           bool    `json:"draft"`
+		Author          struct {
+			Login             string `json:"login"`
+			ID                int64  `json:"id"`
+			AvatarURL         string `json:"avatar_url"`
+			GravatarID        string `json:"gravatar_id"`
+			URL               string `json:"url"`
+			HTMLURL           string `json:"html_url"`
+			FollowersURL      string `json:"followers_url"`
+			FollowingURL      string `json:"following_url"`
+			GistsURL          string `json:"gists_url"`
+			StarredURL        string `json:"starred_url"`
+			SubscriptionsURL  string `json:"subscriptions_url"`
+			OrganizationsURL  string `json:"organizations_url"`
+			ReposURL          string `json:"repos_url"`
+			EventsURL         string `json:"events_url"`
+			ReceivedEventsURL string `json:"received_events_url"`
+			Type              string `json:"type"`
+			SiteAdmin         bool   `json:"site_admin"`
+		} `json:"author"`
+		Prerelease  bool      `json:"prerelease"`
+		CreatedAt   time.Time `json:"created_at"`
+		PublishedAt time.Time `json:"published_at"`
+		Assets      []Asset   `json:"assets"`
+		TarballURL  string    `json:"tarball_url"`
+		ZipballURL  string    `json:"zipball_url"`
+		Body        *string   `json:"body"`
+	} `json:"release"`
+	// Changes holds the previous values of the fields the edited action changed
+	Changes    *Changes `json:"changes,omitempty"`
+	Repository struct {
+		ID       int64  `json:"id"`
+		Name     string `json:"name"`
+		FullName string `json:"full_name"`
+		Owner    struct {
+			Login             string `json:"login"`
+			ID                int64  `json:"id"`
+			AvatarURL         string `json:"avatar_url"`
+			GravatarID        string `json:"gravatar_id"`
+			URL               string `json:"url"`
+			HTMLURL           string `json:"html_url"`
+			FollowersURL      string `json:"followers_url"`
+			FollowingURL      string `json:"following_url"`
+			GistsURL          string `json:"gists_url"`
+			StarredURL        string `json:"starred_url"`
+			SubscriptionsURL  string `json:"subscriptions_url"`
+			OrganizationsURL  string `json:"organizations_url"`
+			ReposURL          string `json:"repos_url"`
+			EventsURL         string `json:"events_url"`
+			ReceivedEventsURL string `json:"received_events_url"`
+			Type              string `json:"type"`
+			SiteAdmin         bool   `json:"site_admin"`
+		} `json:"owner"`
+		Private          bool      `json:"private"`
+		HTMLURL          string    `json:"html_url"`
+		Description      string    `json:"description"`
+		Fork             bool      `json:"fork"`
+		URL              string    `json:"url"`
+		ForksURL         string    `json:"forks_url"`
+		KeysURL          string    `json:"keys_url"`
+		CollaboratorsURL string    `json:"collaborators_url"`
+		TeamsURL         string    `json:"teams_url"`
+		HooksURL         string    `json:"hooks_url"`
+		IssueEventsURL   string    `json:"issue_events_url"`
+		EventsURL        string    `json:"events_url"`
+		AssigneesURL     string    `json:"assignees_url"`
+		BranchesURL      string    `json:"branches_url"`
+		TagsURL          string    `json:"tags_url"`
+		BlobsURL         string    `json:"blobs_url"`
+		GitTagsURL       string    `json:"git_tags_url"`
+		GitRefsURL       string    `json:"git_refs_url"`
+		TreesURL         string    `json:"trees_url"`
+		StatusesURL      string    `json:"statuses_url"`
+		LanguagesURL     string    `json:"languages_url"`
+		StargazersURL    string    `json:"stargazers_url"`
+		ContributorsURL  string    `json:"contributors_url"`
+		SubscribersURL   string    `json:"subscribers_url"`
+		SubscriptionURL  string    `json:"subscription_url"`
+		CommitsURL       string    `json:"commits_url"`
+		GitCommitsURL    string    `json:"git_commits_url"`
+		CommentsURL      string    `json:"comments_url"`
+		IssueCommentURL  string    `json:"issue_comment_url"`
+		ContentsURL      string    `json:"contents_url"`
+		CompareURL       string    `json:"compare_url"`
+		MergesURL        string    `json:"merges_url"`
+		ArchiveURL       string    `json:"archive_url"`
+		DownloadsURL     string    `json:"downloads_url"`
+		IssuesURL        string    `json:"issues_url"`
+		PullsURL         string    `json:"pulls_url"`
+		MilestonesURL    string    `json:"milestones_url"`
+		NotificationsURL string    `json:"notifications_url"`
+		LabelsURL        string    `json:"labels_url"`
+		ReleasesURL      string    `json:"releases_url"`
+		CreatedAt        time.Time `json:"created_at"`
+		UpdatedAt        time.Time `json:"updated_at"`
+		PushedAt         time.Time `json:"pushed_at"`
+		GitURL           string    `json:"git_url"`
+		SSHURL           string    `json:"ssh_url"`
+		CloneURL         string    `json:"clone_url"`
+		SvnURL           string    `json:"svn_url"`
+		Homepage         *string   `json:"homepage"`
+		Size             int64     `json:"size"`
+		StargazersCount  int64     `json:"stargazers_count"`
+		WatchersCount    int64     `json:"watchers_count"`
+		Language         *string   `json:"language"`
+		HasIssues        bool      `json:"has_issues"`
+		HasDownloads     bool      `json:"has_downloads"`
+		HasWiki          bool      `json:"has_wiki"`
+		HasPages         bool      `json:"has_pages"`
+		ForksCount       int64     `json:"forks_count"`
+		MirrorURL        *string   `json:"mirror_url"`
+		OpenIssuesCount  int64     `json:"open_issues_count"`
+		Forks            int64     `json:"forks"`
+		OpenIssues       int64     `json:"open_issues"`
+		Watchers         int64     `json:"watchers"`
+		DefaultBranch    string    `json:"default_branch"`
+	} `json:"repository"`
+	Sender struct {
+		Login             string `json:"login"`
+		ID                int64  `json:"id"`
+		AvatarURL         string `json:"avatar_url"`
+		GravatarID        string `json:"gravatar_id"`
+		URL               string `json:"url"`
+		HTMLURL           string `json:"html_url"`
+		FollowersURL      string `json:"followers_url"`
+		FollowingURL      string `json:"following_url"`
+		GistsURL          string `json:"gists_url"`
+		StarredURL        string `json:"starred_url"`
+		SubscriptionsURL  string `json:"subscriptions_url"`
+		OrganizationsURL  string `json:"organizations_url"`
+		ReposURL          string `json:"repos_url"`
+		EventsURL         string `json:"events_url"`
+		ReceivedEventsURL string `json:"received_events_url"`
+		Type              string `json:"type"`
+		SiteAdmin         bool   `json:"site_admin"`
+	} `json:"sender"`
+	Installation Installation `json:"installation"`
+}
+
+// RepositoryPayload contains the information for GitHub's repository hook event
+type RepositoryPayload struct {
+	Action     string `json:"action"`
+	Repository struct {
+		ID       int64  `json:"id"`
+		Name     string `json:"name"`
+		FullName string `json:"full_name"`
+		Owner    struct {
+			Login             string `json:"login"`
+			ID                int64  `json:"id"`
+			AvatarURL         string `json:"avatar_url"`
+			GravatarID        string `json:"gravatar_id"`
+			URL               string `json:"url"`
+			HTMLURL           string `json:"html_url"`
+			FollowersURL      string `json:"followers_url"`
+			FollowingURL      string `json:"following_url"`
+			GistsURL          string `json:"gists_url"`
+			StarredURL        string `json:"starred_url"`
+			SubscriptionsURL  string `json:"subscriptions_url"`
+			OrganizationsURL  string `json:"organizations_url"`
+			ReposURL          string `json:"repos_url"`
+			EventsURL         string `json:"events_url"`
+			ReceivedEventsURL string `json:"received_events_url"`
+			Type              string `json:"type"`
+			SiteAdmin         bool   `json:"site_admin"`
+		} `json:"owner"`
+		Private          bool      `json:"private"`
+		HTMLURL          string    `json:"html_url"`
+		Description      string    `json:"description"`
+		Fork             bool      `json:"fork"`
+		URL              string    `json:"url"`
+		ForksURL         string    `json:"forks_url"`
+		KeysURL          string    `json:"keys_url"`
+		CollaboratorsURL string    `json:"collaborators_url"`
+		TeamsURL         string    `json:"teams_url"`
+		HooksURL         string    `json:"hooks_url"`
+		IssueEventsURL   string    `json:"issue_events_url"`
+		EventsURL        string    `json:"events_url"`
+		AssigneesURL     string    `json:"assignees_url"`
+		BranchesURL      string    `json:"branches_url"`
+		TagsURL          string    `json:"tags_url"`
+		BlobsURL         string    `json:"blobs_url"`
+		GitTagsURL       string    `json:"git_tags_url"`
+		GitRefsURL       string    `json:"git_refs_url"`
+		TreesURL         string    `json:"trees_url"`
+		StatusesURL      string    `json:"statuses_url"`
+		LanguagesURL     string    `json:"languages_url"`
+		StargazersURL    string    `json:"stargazers_url"`
+		ContributorsURL  string    `json:"contributors_url"`
+		SubscribersURL   string    `json:"subscribers_url"`
+		SubscriptionURL  string    `json:"subscription_url"`
+		CommitsURL       string    `json:"commits_url"`
+		GitCommitsURL    string    `json:"git_commits_url"`
+		CommentsURL      string    `json:"comments_url"`
+		IssueCommentURL  string    `json:"issue_comment_url"`
+		ContentsURL      string    `json:"contents_url"`
+		CompareURL       string    `json:"compare_url"`
+		MergesURL        string    `json:"merges_url"`
+		ArchiveURL       string    `json:"archive_url"`
+		DownloadsURL     string    `json:"downloads_url"`
+		IssuesURL        string    `json:"issues_url"`
+		PullsURL         string    `json:"pulls_url"`
+		MilestonesURL    string    `json:"milestones_url"`
+		NotificationsURL string    `json:"notifications_url"`
+		LabelsURL        string    `json:"labels_url"`
+		ReleasesURL      string    `json:"releases_url"`
+		CreatedAt        time.Time `json:"created_at"`
+		UpdatedAt        time.Time `json:"updated_at"`
+		PushedAt         time.Time `json:"pushed_at"`
+		GitURL           string    `json:"git_url"`
+		SSHURL           string    `json:"ssh_url"`
+		CloneURL         string    `json:"clone_url"`
+		SvnURL           string    `json:"svn_url"`
+		Homepage         *string   `json:"homepage"`
+		Size             int64     `json:"size"`
+		StargazersCount  int64     `json:"stargazers_count"`
+		WatchersCount    int64     `json:"watchers_count"`
+		Language         *string   `json:"language"`
+		HasIssues        bool      `json:"has_issues"`
+		HasDownloads     bool      `json:"has_downloads"`
+		HasWiki          bool      `json:"has_wiki"`
+		HasPages         bool      `json:"has_pages"`
+		ForksCount       int64     `json:"forks_count"`
+		MirrorURL        *string   `json:"mirror_url"`
+		OpenIssuesCount  int64     `json:"open_issues_count"`
+		Forks            int64     `json:"forks"`
+		OpenIssues       int64     `json:"open_issues"`
+		Watchers         int64     `json:"watchers"`
+		DefaultBranch    string    `json:"default_branch"`
+	} `json:"repository"`
+	Organization struct {
+		Login            string `json:"login"`
+		ID               int64  `json:"id"`
+		URL              string `json:"url"`
+		ReposURL         string `json:"repos_url"`
+		EventsURL        string `json:"events_url"`
+		MembersURL       string `json:"members_url"`
+		PublicMembersURL string `json:"public_members_url"`
+		AvatarURL        string `json:"avatar_url"`
+	} `json:"organization"`
+	Sender struct {
+		Login             string `json:"login"`
+		ID                int64  `json:"id"`
+		AvatarURL         string `json:"avatar_url"`
+		GravatarID        string `json:"gravatar_id"`
+		URL               string `json:"url"`
+		HTMLURL           string `json:"html_url"`
+		FollowersURL      string `json:"followers_url"`
+		FollowingURL      string `json:"following_url"`
+		GistsURL          string `json:"gists_url"`
+		StarredURL        string `json:"starred_url"`
+		SubscriptionsURL  string `json:"subscriptions_url"`
+		OrganizationsURL  string `json:"organizations_url"`
+		ReposURL          string `json:"repos_url"`
+		EventsURL         string `json:"events_url"`
+		ReceivedEventsURL string `json:"received_events_url"`
+		Type              string `json:"type"`
+		SiteAdmin         bool   `json:"site_admin"`
+	} `json:"sender"`
+	Installation Installation `json:"installation"`
+}
+
+// RepositoryDispatchPayload contains the information for GitHub's repository_dispatch hook event,
+// ClientPayload is left undecoded as its schema is defined by whoever dispatched the event
+type RepositoryDispatchPayload struct {
+	Action        string          `json:"action"`
+	Branch        string          `json:"branch"`
+	ClientPayload json.RawMessage `json:"client_payload"`
+	Repository    struct {
+		ID       int64  `json:"id"`
+		Name     string `json:"name"`
+		FullName string `json:"full_name"`
+		Owner    struct {
+			Login             string `json:"login"`
+			ID                int64  `json:"id"`
+			AvatarURL         string `json:"avatar_url"`
+			GravatarID        string `json:"gravatar_id"`
+			URL               string `json:"url"`
+			HTMLURL           string `json:"html_url"`
+			FollowersURL      string `json:"followers_url"`
+			FollowingURL      string `json:"following_url"`
+			GistsURL          string `json:"gists_url"`
+			StarredURL        string `json:"starred_url"`
+			SubscriptionsURL  string `json:"subscriptions_url"`
+			OrganizationsURL  string `json:"organizations_url"`
+			ReposURL          string `json:"repos_url"`
+			EventsURL         string `json:"events_url"`
+			ReceivedEventsURL string `json:"received_events_url"`
+			Type              string `json:"type"`
+			SiteAdmin         bool   `json:"site_admin"`
+		} `json:"owner"`
+		Private          bool      `json:"private"`
+		HTMLURL          string    `json:"html_url"`
+		Description      string    `json:"description"`
+		Fork             bool      `json:"fork"`
+		URL              string    `json:"url"`
+		ForksURL         string    `json:"forks_url"`
+		KeysURL          string    `json:"keys_url"`
+		CollaboratorsURL string    `json:"collaborators_url"`
+		TeamsURL         string    `json:"teams_url"`
+		HooksURL         string    `json:"hooks_url"`
+		IssueEventsURL   string    `json:"issue_events_url"`
+		EventsURL        string    `json:"events_url"`
+		AssigneesURL     string    `json:"assignees_url"`
+		BranchesURL      string    `json:"branches_url"`
+		TagsURL          string    `json:"tags_url"`
+		BlobsURL         string    `json:"blobs_url"`
+		GitTagsURL       string    `json:"git_tags_url"`
+		GitRefsURL       string    `json:"git_refs_url"`
+		TreesURL         string    `json:"trees_url"`
+		StatusesURL      string    `json:"statuses_url"`
+		LanguagesURL     string    `json:"languages_url"`
+		StargazersURL    string    `json:"stargazers_url"`
+		ContributorsURL  string    `json:"contributors_url"`
+		SubscribersURL   string    `json:"subscribers_url"`
+		SubscriptionURL  string    `json:"subscription_url"`
+		CommitsURL       string    `json:"commits_url"`
+		GitCommitsURL    string    `json:"git_commits_url"`
+		CommentsURL      string    `json:"comments_url"`
+		IssueCommentURL  string    `json:"issue_comment_url"`
+		ContentsURL      string    `json:"contents_url"`
+		CompareURL       string    `json:"compare_url"`
+		MergesURL        string    `json:"merges_url"`
+		ArchiveURL       string    `json:"archive_url"`
+		DownloadsURL     string    `json:"downloads_url"`
+		IssuesURL        string    `json:"issues_url"`
+		PullsURL         string    `json:"pulls_url"`
+		MilestonesURL    string    `json:"milestones_url"`
+		NotificationsURL string    `json:"notifications_url"`
+		LabelsURL        string    `json:"labels_url"`
+		ReleasesURL      string    `json:"releases_url"`
+		CreatedAt        time.Time `json:"created_at"`
+		UpdatedAt        time.Time `json:"updated_at"`
+		PushedAt         time.Time `json:"pushed_at"`
+		GitURL           string    `json:"git_url"`
+		SSHURL           string    `json:"ssh_url"`
+		CloneURL         string    `json:"clone_url"`
+		SvnURL           string    `json:"svn_url"`
+		Homepage         *string   `json:"homepage"`
+		Size             int64     `json:"size"`
+		StargazersCount  int64     `json:"stargazers_count"`
+		WatchersCount    int64     `json:"watchers_count"`
+		Language         *string   `json:"language"`
+		HasIssues        bool      `json:"has_issues"`
+		HasDownloads     bool      `json:"has_downloads"`
+		HasWiki          bool      `json:"has_wiki"`
+		HasPages         bool      `json:"has_pages"`
+		ForksCount       int64     `json:"forks_count"`
+		MirrorURL        *string   `json:"mirror_url"`
+		OpenIssuesCount  int64     `json:"open_issues_count"`
+		Forks            int64     `json:"forks"`
+		OpenIssues       int64     `json:"open_issues"`
+		Watchers         int64     `json:"watchers"`
+		DefaultBranch    string    `json:"default_branch"`
+	} `json:"repository"`
+	Sender struct {
+		Login             string `json:"login"`
+		ID                int64  `json:"id"`
+		AvatarURL         string `json:"avatar_url"`
+		GravatarID        string `json:"gravatar_id"`
+		URL               string `json:"url"`
+		HTMLURL           string `json:"html_url"`
+		FollowersURL      string `json:"followers_url"`
+		FollowingURL      string `json:"following_url"`
+		GistsURL          string `json:"gists_url"`
+		StarredURL        string `json:"starred_url"`
+		SubscriptionsURL  string `json:"subscriptions_url"`
+		OrganizationsURL  string `json:"organizations_url"`
+		ReposURL          string `json:"repos_url"`
+		EventsURL         string `json:"events_url"`
+		ReceivedEventsURL string `json:"received_events_url"`
+		Type              string `json:"type"`
+		SiteAdmin         bool   `json:"site_admin"`
+	} `json:"sender"`
+	Installation Installation `json:"installation"`
+}
+
+// SecretScanningAlertPayload contains the information for GitHub's secret_scanning_alert hook event
+type SecretScanningAlertPayload struct {
+	Action string `json:"action"`
+	Alert  struct {
+		Number                int64   `json:"number"`
+		SecretType            string  `json:"secret_type"`
+		SecretTypeDisplayName string  `json:"secret_type_display_name"`
+		State                 string  `json:"state"`
+		Resolution            *string `json:"resolution"`
+		ResolvedBy            *struct {
+			Login string `json:"login"`
+			ID    int64  `json:"id"`
+		} `json:"resolved_by"`
+		ResolvedAt             *time.Time `json:"resolved_at"`
+		CreatedAt              time.Time  `json:"created_at"`
+		UpdatedAt              *time.Time `json:"updated_at"`
+		URL                    string     `json:"url"`
+		HTMLURL                string     `json:"html_url"`
+		LocationsURL           string     `json:"locations_url"`
+		PushProtectionBypassed bool       `json:"push_protection_bypassed"`
+	} `json:"alert"`
+	Repository struct {
+		ID       int64  `json:"id"`
+		Name     string `json:"name"`
+		FullName string `json:"full_name"`
+		Owner    struct {
+			Login             string `json:"login"`
+			ID                int64  `json:"id"`
+			AvatarURL         string `json:"avatar_url"`
+			GravatarID        string `json:"gravatar_id"`
+			URL               string `json:"url"`
+			HTMLURL           string `json:"html_url"`
+			FollowersURL      string `json:"followers_url"`
+			FollowingURL      string `json:"following_url"`
+			GistsURL          string `json:"gists_url"`
+			StarredURL        string `json:"starred_url"`
+			SubscriptionsURL  string `json:"subscriptions_url"`
+			OrganizationsURL  string `json:"organizations_url"`
+			ReposURL          string `json:"repos_url"`
+			EventsURL         string `json:"events_url"`
+			ReceivedEventsURL string `json:"received_events_url"`
+			Type              string `json:"type"`
+			SiteAdmin         bool   `json:"site_admin"`
+		} `json:"owner"`
+		Private          bool      `json:"private"`
+		HTMLURL          string    `json:"html_url"`
+		Description      string    `json:"description"`
+		Fork             bool      `json:"fork"`
+		URL              string    `json:"url"`
+		ForksURL         string    `json:"forks_url"`
+		KeysURL          string    `json:"keys_url"`
+		CollaboratorsURL string    `json:"collaborators_url"`
+		TeamsURL         string    `json:"teams_url"`
+		HooksURL         string    `json:"hooks_url"`
+		IssueEventsURL   string    `json:"issue_events_url"`
+		EventsURL        string    `json:"events_url"`
+		AssigneesURL     string    `json:"assignees_url"`
+		BranchesURL      string    `json:"branches_url"`
+		TagsURL          string    `json:"tags_url"`
+		BlobsURL         string    `json:"blobs_url"`
+		GitTagsURL       string    `json:"git_tags_url"`
+		GitRefsURL       string    `json:"git_refs_url"`
+		TreesURL         string    `json:"trees_url"`
+		StatusesURL      string    `json:"statuses_url"`
+		LanguagesURL     string    `json:"languages_url"`
+		StargazersURL    string    `json:"stargazers_url"`
+		ContributorsURL  string    `json:"contributors_url"`
+		SubscribersURL   string    `json:"subscribers_url"`
+		SubscriptionURL  string    `json:"subscription_url"`
+		CommitsURL       string    `json:"commits_url"`
+		GitCommitsURL    string    `json:"git_commits_url"`
+		CommentsURL      string    `json:"comments_url"`
+		IssueCommentURL  string    `json:"issue_comment_url"`
+		ContentsURL      string    `json:"contents_url"`
+		CompareURL       string    `json:"compare_url"`
+		MergesURL        string    `json:"merges_url"`
+		ArchiveURL       string    `json:"archive_url"`
+		DownloadsURL     string    `json:"downloads_url"`
+		IssuesURL        string    `json:"issues_url"`
+		PullsURL         string    `json:"pulls_url"`
+		MilestonesURL    string    `json:"milestones_url"`
+		NotificationsURL string    `json:"notifications_url"`
+		LabelsURL        string    `json:"labels_url"`
+		ReleasesURL      string    `json:"releases_url"`
+		CreatedAt        time.Time `json:"created_at"`
+		UpdatedAt        time.Time `json:"updated_at"`
+		PushedAt         time.Time `json:"pushed_at"`
+		GitURL           string    `json:"git_url"`
+		SSHURL           string    `json:"ssh_url"`
+		CloneURL         string    `json:"clone_url"`
+		SvnURL           string    `json:"svn_url"`
+		Homepage         *string   `json:"homepage"`
+		Size             int64     `json:"size"`
+		StargazersCount  int64     `json:"stargazers_count"`
+		WatchersCount    int64     `json:"watchers_count"`
+		Language         *string   `json:"language"`
+		HasIssues        bool      `json:"has_issues"`
+		HasDownloads     bool      `json:"has_downloads"`
+		HasWiki          bool      `json:"has_wiki"`
+		HasPages         bool      `json:"has_pages"`
+		ForksCount       int64     `json:"forks_count"`
+		MirrorURL        *string   `json:"mirror_url"`
+		OpenIssuesCount  int64     `json:"open_issues_count"`
+		Forks            int64     `json:"forks"`
+		OpenIssues       int64     `json:"open_issues"`
+		Watchers         int64     `json:"watchers"`
+		DefaultBranch    string    `json:"default_branch"`
+	} `json:"repository"`
+	Sender struct {
+		Login             string `json:"login"`
+		ID                int64  `json:"id"`
+		AvatarURL         string `json:"avatar_url"`
+		GravatarID        string `json:"gravatar_id"`
+		URL               string `json:"url"`
+		HTMLURL           string `json:"html_url"`
+		FollowersURL      string `json:"followers_url"`
+		FollowingURL      string `json:"following_url"`
+		GistsURL          string `json:"gists_url"`
+		StarredURL        string `json:"starred_url"`
+		SubscriptionsURL  string `json:"subscriptions_url"`
+		OrganizationsURL  string `json:"organizations_url"`
+		ReposURL          string `json:"repos_url"`
+		EventsURL         string `json:"events_url"`
+		ReceivedEventsURL string `json:"received_events_url"`
+		Type              string `json:"type"`
+		SiteAdmin         bool   `json:"site_admin"`
+	} `json:"sender"`
+	Installation Installation `json:"installation"`
+}
+
+// SecretScanningAlertLocationPayload contains the information for GitHub's secret_scanning_alert_location hook event
+type SecretScanningAlertLocationPayload struct {
+	Action string `json:"action"`
+	Alert  struct {
+		Number                int64   `json:"number"`
+		SecretType            string  `json:"secret_type"`
+		SecretTypeDisplayName string  `json:"secret_type_display_name"`
+		State                 string  `json:"state"`
+		Resolution            *string `json:"resolution"`
+		ResolvedBy            *struct {
+			Login string `json:"login"`
+			ID    int64  `json:"id"`
+		} `json:"resolved_by"`
+		ResolvedAt             *time.Time `json:"resolved_at"`
+		CreatedAt              time.Time  `json:"created_at"`
+		UpdatedAt              *time.Time `json:"updated_at"`
+		URL                    string     `json:"url"`
+		HTMLURL                string     `json:"html_url"`
+		LocationsURL           string     `json:"locations_url"`
+		PushProtectionBypassed bool       `json:"push_protection_bypassed"`
+	} `json:"alert"`
+	Location struct {
+		Type    string `json:"type"`
+		Details struct {
+			Path        string `json:"path"`
+			StartLine   int64  `json:"start_line"`
+			EndLine     int64  `json:"end_line"`
+			StartColumn int64  `json:"start_column"`
+			EndColumn   int64  `json:"end_column"`
+			BlobSha     string `json:"blob_sha"`
+			BlobURL     string `json:"blob_url"`
+			CommitSha   string `json:"commit_sha"`
+			CommitURL   string `json:"commit_url"`
+		} `json:"details"`
+	} `json:"location"`
+	Repository struct {
+		ID       int64  `json:"id"`
+		Name     string `json:"name"`
+		FullName string `json:"full_name"`
+		Owner    struct {
+			Login             string `json:"login"`
+			ID                int64  `json:"id"`
+			AvatarURL         string `json:"avatar_url"`
+			GravatarID        string `json:"gravatar_id"`
+			URL               string `json:"url"`
+			HTMLURL           string `json:"html_url"`
+			FollowersURL      string `json:"followers_url"`
+			FollowingURL      string `json:"following_url"`
+			GistsURL          string `json:"gists_url"`
+			StarredURL        string `json:"starred_url"`
+			SubscriptionsURL  string `json:"subscriptions_url"`
+			OrganizationsURL  string `json:"organizations_url"`
+			ReposURL          string `json:"repos_url"`
+			EventsURL         string `json:"events_url"`
+			ReceivedEventsURL string `json:"received_events_url"`
+			Type              string `json:"type"`
+			SiteAdmin         bool   `json:"site_admin"`
+		} `json:"owner"`
+		Private          bool      `json:"private"`
+		HTMLURL          string    `json:"html_url"`
+		Description      string    `json:"description"`
+		Fork             bool      `json:"fork"`
+		URL              string    `json:"url"`
+		ForksURL         string    `json:"forks_url"`
+		KeysURL          string    `json:"keys_url"`
+		CollaboratorsURL string    `json:"collaborators_url"`
+		TeamsURL         string    `json:"teams_url"`
+		HooksURL         string    `json:"hooks_url"`
+		IssueEventsURL   string    `json:"issue_events_url"`
+		EventsURL        string    `json:"events_url"`
+		AssigneesURL     string    `json:"assignees_url"`
+		BranchesURL      string    `json:"branches_url"`
+		TagsURL          string    `json:"tags_url"`
+		BlobsURL         string    `json:"blobs_url"`
+		GitTagsURL       string    `json:"git_tags_url"`
+		GitRefsURL       string    `json:"git_refs_url"`
+		TreesURL         string    `json:"trees_url"`
+		StatusesURL      string    `json:"statuses_url"`
+		LanguagesURL     string    `json:"languages_url"`
+		StargazersURL    string    `json:"stargazers_url"`
+		ContributorsURL  string    `json:"contributors_url"`
+		SubscribersURL   string    `json:"subscribers_url"`
+		SubscriptionURL  string    `json:"subscription_url"`
+		CommitsURL       string    `json:"commits_url"`
+		GitCommitsURL    string    `json:"git_commits_url"`
+		CommentsURL      string    `json:"comments_url"`
+		IssueCommentURL  string    `json:"issue_comment_url"`
+		ContentsURL      string    `json:"contents_url"`
+		CompareURL       string    `json:"compare_url"`
+		MergesURL        string    `json:"merges_url"`
+		ArchiveURL       string    `json:"archive_url"`
+		DownloadsURL     string    `json:"downloads_url"`
+		IssuesURL        string    `json:"issues_url"`
+		PullsURL         string    `json:"pulls_url"`
+		MilestonesURL    string    `json:"milestones_url"`
+		NotificationsURL string    `json:"notifications_url"`
+		LabelsURL        string    `json:"labels_url"`
+		ReleasesURL      string    `json:"releases_url"`
+		CreatedAt        time.Time `json:"created_at"`
+		UpdatedAt        time.Time `json:"updated_at"`
+		PushedAt         time.Time `json:"pushed_at"`
+		GitURL           string    `json:"git_url"`
+		SSHURL           string    `json:"ssh_url"`
+		CloneURL         string    `json:"clone_url"`
+		SvnURL           string    `json:"svn_url"`
+		Homepage         *string   `json:"homepage"`
+		Size             int64     `json:"size"`
+		StargazersCount  int64     `json:"stargazers_count"`
+		WatchersCount    int64     `json:"watchers_count"`
+		Language         *string   `json:"language"`
+		HasIssues        bool      `json:"has_issues"`
+		HasDownloads     bool      `json:"has_downloads"`
+		HasWiki          bool      `json:"has_wiki"`
+		HasPages         bool      `json:"has_pages"`
+		ForksCount       int64     `json:"forks_count"`
+		MirrorURL        *string   `json:"mirror_url"`
+		OpenIssuesCount  int64     `json:"open_issues_count"`
+		Forks            int64     `json:"forks"`
+		OpenIssues       int64     `json:"open_issues"`
+		Watchers         int64     `json:"watchers"`
+		DefaultBranch    string    `json:"default_branch"`
+	} `json:"repository"`
+	Sender struct {
+		Login             string `json:"login"`
+		ID                int64  `json:"id"`
+		AvatarURL         string `json:"avatar_url"`
+		GravatarID        string `json:"gravatar_id"`
+		URL               string `json:"url"`
+		HTMLURL           string `json:"html_url"`
+		FollowersURL      string `json:"followers_url"`
+		FollowingURL      string `json:"following_url"`
+		GistsURL          string `json:"gists_url"`
+		StarredURL        string `json:"starred_url"`
+		SubscriptionsURL  string `json:"subscriptions_url"`
+		OrganizationsURL  string `json:"organizations_url"`
+		ReposURL          string `json:"repos_url"`
+		EventsURL         string `json:"events_url"`
+		ReceivedEventsURL string `json:"received_events_url"`
+		Type              string `json:"type"`
+		SiteAdmin         bool   `json:"site_admin"`
+	} `json:"sender"`
+	Installation Installation `json:"installation"`
+}
+
+// SponsorshipPayload contains the information for GitHub's sponsorship hook event
+type SponsorshipPayload struct {
+	Action string `json:"action"`
+	// EffectiveDate is when a pending cancellation or tier change takes effect
+	EffectiveDate *time.Time `json:"effective_date,omitempty"`
+	Sponsorship   struct {
+		NodeID      string    `json:"node_id"`
+		CreatedAt   time.Time `json:"created_at"`
+		Sponsorable struct {
+			Login             string `json:"login"`
+			ID                int64  `json:"id"`
+			AvatarURL         string `json:"avatar_url"`
+			GravatarID        string `json:"gravatar_id"`
+			URL               string `json:"url"`
+			HTMLURL           string `json:"html_url"`
+			FollowersURL      string `json:"followers_url"`
+			FollowingURL      string `json:"following_url"`
+			GistsURL          string `json:"gists_url"`
+			StarredURL        string `json:"starred_url"`
+			SubscriptionsURL  string `json:"subscriptions_url"`
+			OrganizationsURL  string `json:"organizations_url"`
+			ReposURL          string `json:"repos_url"`
+			EventsURL         string `json:"events_url"`
+			ReceivedEventsURL string `json:"received_events_url"`
+			Type              string `json:"type"`
+			SiteAdmin         bool   `json:"site_admin"`
+		} `json:"sponsorable"`
+		Sponsor struct {
+			Login             string `json:"login"`
+			ID                int64  `json:"id"`
+			AvatarURL         string `json:"avatar_url"`
+			GravatarID        string `json:"gravatar_id"`
+			URL               string `json:"url"`
+			HTMLURL           string `json:"html_url"`
+			FollowersURL      string `json:"followers_url"`
+			FollowingURL      string `json:"following_url"`
+			GistsURL          string `json:"gists_url"`
+			StarredURL        string `json:"starred_url"`
+			SubscriptionsURL  string `json:"subscriptions_url"`
+			OrganizationsURL  string `json:"organizations_url"`
+			ReposURL          string `json:"repos_url"`
+			EventsURL         string `json:"events_url"`
+			ReceivedEventsURL string `json:"received_events_url"`
+			Type              string `json:"type"`
+			SiteAdmin         bool   `json:"site_admin"`
+		} `json:"sponsor"`
+		PrivacyLevel string          `json:"privacy_level"`
+		Tier         SponsorshipTier `json:"tier"`
+	} `json:"sponsorship"`
+	// Changes holds the previous tier for the tier_changed and pending_tier_change actions
+	Changes *struct {
+		Tier struct {
+			From SponsorshipTier `json:"from"`
+		} `json:"tier"`
+	} `json:"changes,omitempty"`
+	Sender struct {
+		Login             string `json:"login"`
+		ID                int64  `json:"id"`
+		AvatarURL         string `json:"avatar_url"`
+		GravatarID        string `json:"gravatar_id"`
+		URL               string `json:"url"`
+		HTMLURL           string `json:"html_url"`
+		FollowersURL      string `json:"followers_url"`
+		FollowingURL      string `json:"following_url"`
+		GistsURL          string `json:"gists_url"`
+		StarredURL        string `json:"starred_url"`
+		SubscriptionsURL  string `json:"subscriptions_url"`
+		OrganizationsURL  string `json:"organizations_url"`
+		ReposURL          string `json:"repos_url"`
+		EventsURL         string `json:"events_url"`
+		ReceivedEventsURL string `json:"received_events_url"`
+		Type              string `json:"type"`
+		SiteAdmin         bool   `json:"site_admin"`
+	} `json:"sender"`
+}
+
+// StarPayload contains the information for GitHub's star hook event
+type StarPayload struct {
+	Action     string     `json:"action"`
+	StarredAt  *time.Time `json:"starred_at"`
+	Repository struct {
+		ID       int64  `json:"id"`
+		Name     string `json:"name"`
+		FullName string `json:"full_name"`
+		Owner    struct {
+			Login             string `json:"login"`
+			ID                int64  `json:"id"`
+			AvatarURL         string `json:"avatar_url"`
+			GravatarID        string `json:"gravatar_id"`
+			URL               string `json:"url"`
+			HTMLURL           string `json:"html_url"`
+			FollowersURL      string `json:"followers_url"`
+			FollowingURL      string `json:"following_url"`
+			GistsURL          string `json:"gists_url"`
+			StarredURL        string `json:"starred_url"`
+			SubscriptionsURL  string `json:"subscriptions_url"`
+			OrganizationsURL  string `json:"organizations_url"`
+			ReposURL          string `json:"repos_url"`
+			EventsURL         string `json:"events_url"`
+			ReceivedEventsURL string `json:"received_events_url"`
+			Type              string `json:"type"`
+			SiteAdmin         bool   `json:"site_admin"`
+		} `json:"owner"`
+		Private          bool      `json:"private"`
+		HTMLURL          string    `json:"html_url"`
+		Description      string    `json:"description"`
+		Fork             bool      `json:"fork"`
+		URL              string    `json:"url"`
+		ForksURL         string    `json:"forks_url"`
+		KeysURL          string    `json:"keys_url"`
+		CollaboratorsURL string    `json:"collaborators_url"`
+		TeamsURL         string    `json:"teams_url"`
+		HooksURL         string    `json:"hooks_url"`
+		IssueEventsURL   string    `json:"issue_events_url"`
+		EventsURL        string    `json:"events_url"`
+		AssigneesURL     string    `json:"assignees_url"`
+		BranchesURL      string    `json:"branches_url"`
+		TagsURL          string    `json:"tags_url"`
+		BlobsURL         string    `json:"blobs_url"`
+		GitTagsURL       string    `json:"git_tags_url"`
+		GitRefsURL       string    `json:"git_refs_url"`
+		TreesURL         string    `json:"trees_url"`
+		StatusesURL      string    `json:"statuses_url"`
+		LanguagesURL     string    `json:"languages_url"`
+		StargazersURL    string    `json:"stargazers_url"`
+		ContributorsURL  string    `json:"contributors_url"`
+		SubscribersURL   string    `json:"subscribers_url"`
+		SubscriptionURL  string    `json:"subscription_url"`
+		CommitsURL       string    `json:"commits_url"`
+		GitCommitsURL    string    `json:"git_commits_url"`
+		CommentsURL      string    `json:"comments_url"`
+		IssueCommentURL  string    `json:"issue_comment_url"`
+		ContentsURL      string    `json:"contents_url"`
+		CompareURL       string    `json:"compare_url"`
+		MergesURL        string    `json:"merges_url"`
+		ArchiveURL       string    `json:"archive_url"`
+		DownloadsURL     string    `json:"downloads_url"`
+		IssuesURL        string    `json:"issues_url"`
+		PullsURL         string    `json:"pulls_url"`
+		MilestonesURL    string    `json:"milestones_url"`
+		NotificationsURL string    `json:"notifications_url"`
+		LabelsURL        string    `json:"labels_url"`
+		ReleasesURL      string    `json:"releases_url"`
+		CreatedAt        time.Time `json:"created_at"`
+		UpdatedAt        time.Time `json:"updated_at"`
+		PushedAt         time.Time `json:"pushed_at"`
+		GitURL           string    `json:"git_url"`
+		SSHURL           string    `json:"ssh_url"`
+		CloneURL         string    `json:"clone_url"`
+		SvnURL           string    `json:"svn_url"`
+		Homepage         *string   `json:"homepage"`
+		Size             int64     `json:"size"`
+		StargazersCount  int64     `json:"stargazers_count"`
+		WatchersCount    int64     `json:"watchers_count"`
+		Language         *string   `json:"language"`
+		HasIssues        bool      `json:"has_issues"`
+		HasDownloads     bool      `json:"has_downloads"`
+		HasWiki          bool      `json:"has_wiki"`
+		HasPages         bool      `json:"has_pages"`
+		ForksCount       int64     `json:"forks_count"`
+		MirrorURL        *string   `json:"mirror_url"`
+		OpenIssuesCount  int64     `json:"open_issues_count"`
+		Forks            int64     `json:"forks"`
+		OpenIssues       int64     `json:"open_issues"`
+		Watchers         int64     `json:"watchers"`
+		DefaultBranch    string    `json:"default_branch"`
+	} `json:"repository"`
+	Sender struct {
+		Login             string `json:"login"`
+		ID                int64  `json:"id"`
+		AvatarURL         string `json:"avatar_url"`
+		GravatarID        string `json:"gravatar_id"`
+		URL               string `json:"url"`
+		HTMLURL           string `json:"html_url"`
+		FollowersURL      string `json:"followers_url"`
+		FollowingURL      string `json:"following_url"`
+		GistsURL          string `json:"gists_url"`
+		StarredURL        string `json:"starred_url"`
+		SubscriptionsURL  string `json:"subscriptions_url"`
+		OrganizationsURL  string `json:"organizations_url"`
+		ReposURL          string `json:"repos_url"`
+		EventsURL         string `json:"events_url"`
+		ReceivedEventsURL string `json:"received_events_url"`
+		Type              string `json:"type"`
+		SiteAdmin         bool   `json:"site_admin"`
+	} `json:"sender"`
+	Installation Installation `json:"installation"`
 }
 
-// PushPayload contains the information for GitHub's push hook event
-type PushPayload struct {
-	Ref     string  `json:"ref"`
-	Before  string  `json:"before"`
-	After   string  `json:"after"`
-	Created bool    `json:"created"`
-	Deleted bool    `json:"deleted"`
-	Forced  bool    `json:"forced"`
-	BaseRef *string `json:"base_ref"`
-	Compare string  `json:"compare"`
-	Commits []struct {
-		Sha       string `json:"sha"`
-		ID        string `json:"id"`
-		TreeID    string `json:"tree_id"`
-		Distinct  bool   `json:"distinct"`
-		Message   string `json:"message"`
-		Timestamp string `json:"timestamp"`
-		URL       string `json:"url"`
-		Author    struct {
-			Name     string `json:"name"`
-			Email    string `json:"email"`
-			Username string `json:"username"`
-		} `json:"author"`
-		Committer struct {
-			Name     string `json:"name"`
-			Email    string `json:"email"`
-			Username string `json:"username"`
-		} `json:"committer"`
-		Added    []string `json:"added"`
-		Removed  []string `json:"removed"`
-		Modified []string `json:"modified"`
-	} `json:"commits"`
-	HeadCommit struct {
-		ID        string `json:"id"`
-		TreeID    string `json:"tree_id"`
-		Distinct  bool   `json:"distinct"`
-		Message   string `json:"message"`
-		Timestamp string `json:"timestamp"`
-		URL       string `json:"url"`
-		Author    struct {
-			Name     string `json:"name"`
-			Email    string `json:"email"`
-			Username string `json:"username"`
+// StatusPayload contains the information for GitHub's status hook event
+type StatusPayload struct {
+	ID          int64   `json:"id"`
+	Sha         string  `json:"sha"`
+	Name        string  `json:"name"`
+	TargetURL   *string `json:"target_url"`
+	Context     string  `json:"context"`
+	Description *string `json:"description"`
+	State       string  `json:"state"`
+	Commit      struct {
+		Sha    string `json:"sha"`
+		Commit struct {
+			Author struct {
+				Name  string    `json:"name"`
+				Email string    `json:"email"`
+				Date  time.Time `json:"date"`
+			} `json:"author"`
+			Committer struct {
+				Name  string    `json:"name"`
+				Email string    `json:"email"`
+				Date  time.Time `json:"date"`
+			} `json:"committer"`
+			Message string `json:"message"`
+			Tree    struct {
+				Sha string `json:"sha"`
+				URL string `json:"url"`
+			} `json:"tree"`
+			URL          string `json:"url"`
+			CommentCount int64  `json:"comment_count"`
+		} `json:"commit"`
+		URL         string `json:"url"`
+		HTMLURL     string `json:"html_url"`
+		CommentsURL string `json:"comments_url"`
+		Author      struct {
+			Login             string `json:"login"`
+			ID                int64  `json:"id"`
+			AvatarURL         string `json:"avatar_url"`
+			GravatarID        string `json:"gravatar_id"`
+			URL               string `json:"url"`
+			HTMLURL           string `json:"html_url"`
+			FollowersURL      string `json:"followers_url"`
+			FollowingURL      string `json:"following_url"`
+			GistsURL          string `json:"gists_url"`
+			StarredURL        string `json:"starred_url"`
+			SubscriptionsURL  string `json:"subscriptions_url"`
+			OrganizationsURL  string `json:"organizations_url"`
+			ReposURL          string `json:"repos_url"`
+			EventsURL         string `json:"events_url"`
+			ReceivedEventsURL string `json:"received_events_url"`
+			Type              string `json:"type"`
+			SiteAdmin         bool   `json:"site_admin"`
 		} `json:"author"`
 		Committer struct {
-			Name     string `json:"name"`
-			Email    string `json:"email"`
-			Username string `json:"username"`
+			Login             string `json:"login"`
+			ID                int64  `json:"id"`
+			AvatarURL         string `json:"avatar_url"`
+			GravatarID        string `json:"gravatar_id"`
+			URL               string `json:"url"`
+			HTMLURL           string `json:"html_url"`
+			FollowersURL      string `json:"followers_url"`
+			FollowingURL      string `json:"following_url"`
+			GistsURL          string `json:"gists_url"`
+			StarredURL        string `json:"starred_url"`
+			SubscriptionsURL  string `json:"subscriptions_url"`
+			OrganizationsURL  string `json:"organizations_url"`
+			ReposURL          string `json:"repos_url"`
+			EventsURL         string `json:"events_url"`
+			ReceivedEventsURL string `json:"received_events_url"`
+			Type              string `json:"type"`
+			SiteAdmin         bool   `json:"site_admin"`
 		} `json:"committer"`
-		Added    []string `json:"added"`
-		Removed  []string `json:"removed"`
-		Modified []string `json:"modified"`
-	} `json:"head_commit"`
+		Parents []Parent `json:"parents"`
+	} `json:"commit"`
+	Branches []struct {
+		Name   string `json:"name"`
+		Commit struct {
+			Sha string `json:"sha"`
+			URL string `json:"url"`
+		} `json:"commit"`
+	} `json:"branches"`
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
 	Repository struct {
 		ID       int64  `json:"id"`
 		Name     string `json:"name"`
 		FullName string `json:"full_name"`
 		Owner    struct {
-			Name  string `json:"name"`
-			Email string `json:"email"`
+			Login             string `json:"login"`
+			ID                int64  `json:"id"`
+			AvatarURL         string `json:"avatar_url"`
+			GravatarID        string `json:"gravatar_id"`
+			URL               string `json:"url"`
+			HTMLURL           string `json:"html_url"`
+			FollowersURL      string `json:"followers_url"`
+			FollowingURL      string `json:"following_url"`
+			GistsURL          string `json:"gists_url"`
+			StarredURL        string `json:"starred_url"`
+			SubscriptionsURL  string `json:"subscriptions_url"`
+			OrganizationsURL  string `json:"organizations_url"`
+			ReposURL          string `json:"repos_url"`
+			EventsURL         string `json:"events_url"`
+			ReceivedEventsURL string `json:"received_events_url"`
+			Type              string `json:"type"`
+			SiteAdmin         bool   `json:"site_admin"`
 		} `json:"owner"`
 		Private          bool      `json:"private"`
 		HTMLURL          string    `json:"html_url"`
@@ -4160,9 +7386,9 @@ type PushPayload struct {
 		NotificationsURL string    `json:"notifications_url"`
 		LabelsURL        string    `json:"labels_url"`
 		ReleasesURL      string    `json:"releases_url"`
-		CreatedAt        int64     `json:"created_at"`
+		CreatedAt        time.Time `json:"created_at"`
 		UpdatedAt        time.Time `json:"updated_at"`
-		PushedAt         int64     `json:"pushed_at"`
+		PushedAt         time.Time `json:"pushed_at"`
 		GitURL           string    `json:"git_url"`
 		SSHURL           string    `json:"ssh_url"`
 		CloneURL         string    `json:"clone_url"`
@@ -4183,13 +7409,7 @@ type PushPayload struct {
 		OpenIssues       int64     `json:"open_issues"`
 		Watchers         int64     `json:"watchers"`
 		DefaultBranch    string    `json:"default_branch"`
-		Stargazers       int64     `json:"stargazers"`
-		MasterBranch     string    `json:"master_branch"`
 	} `json:"repository"`
-	Pusher struct {
-		Name  string `json:"name"`
-		Email string `json:"email"`
-	} `json:"pusher"`
 	Sender struct {
 		Login             string `json:"login"`
 		ID                int64  `json:"id"`
@@ -4201,57 +7421,34 @@ type PushPayload struct {
 		FollowingURL      string `json:"following_url"`
 		GistsURL          string `json:"gists_url"`
 		StarredURL        string `json:"starred_url"`
-		SubscriptionsURL  string `json:"subscriptions_url"`
-		OrganizationsURL  string `json:"organizations_url"`
-		ReposURL          string `json:"repos_url"`
-		EventsURL         string `json:"events_url"`
-		ReceivedEventsURL string `json:"received_events_url"`
-		Type              string `json:"type"`
-		SiteAdmin         bool   `json:"site_admin"`
-	} `json:"sender"`
-}
-
-// ReleasePayload contains the information for GitHub's release hook event
-type ReleasePayload struct {
-	Action  string `json:"action"`
-	Release struct {
-		URL             string  `json:"url"`
-		AssetsURL       string  `json:"assets_url"`
-		UploadURL       string  `json:"upload_url"`
-		HTMLURL         string  `json:"html_url"`
-		ID              int64   `json:"id"`
-		TagName         string  `json:"tag_name"`
-		TargetCommitish string  `json:"target_commitish"`
-		Name            *string `json:"name"`
-		Draft           bool    `json:"draft"`
-		Author          struct {
-			Login             string `json:"login"`
-			ID                int64  `json:"id"`
-			AvatarURL         string `json:"avatar_url"`
-			GravatarID        string `json:"gravatar_id"`
-			URL               string `json:"url"`
-			HTMLURL           string `json:"html_url"`
-			FollowersURL      string `json:"followers_url"`
-			FollowingURL      string `json:"following_url"`
-			GistsURL          string `json:"gists_url"`
-			StarredURL        string `json:"starred_url"`
-			SubscriptionsURL  string `json:"subscriptions_url"`
-			OrganizationsURL  string `json:"organizations_url"`
-			ReposURL          string `json:"repos_url"`
-			EventsURL         string `json:"events_url"`
-			ReceivedEventsURL string `json:"received_events_url"`
-			Type              string `json:"type"`
-			SiteAdmin         bool   `json:"site_admin"`
-		} `json:"author"`
-		Prerelease  bool      `json:"prerelease"`
-		CreatedAt   time.Time `json:"created_at"`
-		PublishedAt time.Time `json:"published_at"`
-		Assets      []Asset   `json:"assets"`
-		TarballURL  string    `json:"tarball_url"`
-		ZipballURL  string    `json:"zipball_url"`
-		Body        *string   `json:"body"`
-	} `json:"release"`
-	Repository struct {
+		SubscriptionsURL  string `json:"subscriptions_url"`
+		OrganizationsURL  string `json:"organizations_url"`
+		ReposURL          string `json:"repos_url"`
+		EventsURL         string `json:"events_url"`
+		ReceivedEventsURL string `json:"received_events_url"`
+		Type              string `json:"type"`
+		SiteAdmin         bool   `json:"site_admin"`
+	} `json:"sender"`
+	Installation Installation `json:"installation"`
+}
+
+// TeamPayload contains the information for GitHub's team hook event
+type TeamPayload struct {
+	Action string `json:"action"`
+	Team   struct {
+		Name            string `json:"name"`
+		ID              int64  `json:"id"`
+		Slug            string `json:"slug"`
+		Description     string `json:"description"`
+		Privacy         string `json:"privacy"`
+		URL             string `json:"url"`
+		MembersURL      string `json:"members_url"`
+		RepositoriesURL string `json:"repositories_url"`
+		Permission      string `json:"permission"`
+	} `json:"team"`
+	// Repository is the repository the team was added to or removed from by the added_to_repository
+	// and removed_from_repository actions, or whose access the edited action changed
+	Repository *struct {
 		ID       int64  `json:"id"`
 		Name     string `json:"name"`
 		FullName string `json:"full_name"`
@@ -4337,7 +7534,24 @@ type ReleasePayload struct {
 		OpenIssues       int64     `json:"open_issues"`
 		Watchers         int64     `json:"watchers"`
 		DefaultBranch    string    `json:"default_branch"`
-	} `json:"repository"`
+		// Permissions are the permissions the team has on the repository
+		Permissions *TeamRepoPermissions `json:"permissions,omitempty"`
+	} `json:"repository,omitempty"`
+	// Changes holds the previous values of the fields the edited action changed
+	Changes      *TeamChanges `json:"changes,omitempty"`
+	Organization struct {
+		Login            string `json:"login"`
+		ID               int64  `json:"id"`
+		URL              string `json:"url"`
+		ReposURL         string `json:"repos_url"`
+		EventsURL        string `json:"events_url"`
+		HooksURL         string `json:"hooks_url"`
+		IssuesURL        string `json:"issues_url"`
+		MembersURL       string `json:"members_url"`
+		PublicMembersURL string `json:"public_members_url"`
+		AvatarURL        string `json:"avatar_url"`
+		Description      string `json:"description"`
+	} `json:"organization"`
 	Sender struct {
 		Login             string `json:"login"`
 		ID                int64  `json:"id"`
@@ -4357,11 +7571,21 @@ type ReleasePayload struct {
 		Type              string `json:"type"`
 		SiteAdmin         bool   `json:"site_admin"`
 	} `json:"sender"`
+	Installation Installation `json:"installation"`
 }
 
-// RepositoryPayload contains the information for GitHub's repository hook event
-type RepositoryPayload struct {
-	Action     string `json:"action"`
+// TeamAddPayload contains the information for GitHub's team_add hook event
+type TeamAddPayload struct {
+	Team struct {
+		Name            string `json:"name"`
+		ID              int64  `json:"id"`
+		Slug            string `json:"slug"`
+		Description     string `json:"description"`
+		Permission      string `json:"permission"`
+		URL             string `json:"url"`
+		MembersURL      string `json:"members_url"`
+		RepositoriesURL string `json:"repositories_url"`
+	} `json:"team"`
 	Repository struct {
 		ID       int64  `json:"id"`
 		Name     string `json:"name"`
@@ -4450,14 +7674,15 @@ type RepositoryPayload struct {
 		DefaultBranch    string    `json:"default_branch"`
 	} `json:"repository"`
 	Organization struct {
-		Login            string `json:"login"`
-		ID               int64  `json:"id"`
-		URL              string `json:"url"`
-		ReposURL         string `json:"repos_url"`
-		EventsURL        string `json:"events_url"`
-		MembersURL       string `json:"members_url"`
-		PublicMembersURL string `json:"public_members_url"`
-		AvatarURL        string `json:"avatar_url"`
+		Login            string  `json:"login"`
+		ID               int64   `json:"id"`
+		URL              string  `json:"url"`
+		ReposURL         string  `json:"repos_url"`
+		EventsURL        string  `json:"events_url"`
+		MembersURL       string  `json:"members_url"`
+		PublicMembersURL string  `json:"public_members_url"`
+		AvatarURL        string  `json:"avatar_url"`
+		Description      *string `json:"description"`
 	} `json:"organization"`
 	Sender struct {
 		Login             string `json:"login"`
@@ -4478,90 +7703,12 @@ type RepositoryPayload struct {
 		Type              string `json:"type"`
 		SiteAdmin         bool   `json:"site_admin"`
 	} `json:"sender"`
+	Installation Installation `json:"installation"`
 }
 
-// StatusPayload contains the information for GitHub's status hook event
-type StatusPayload struct {
-	ID          int64   `json:"id"`
-	Sha         string  `json:"sha"`
-	Name        string  `json:"name"`
-	TargetURL   *string `json:"target_url"`
-	Context     string  `json:"context"`
-	Description *string `json:"description"`
-	State       string  `json:"state"`
-	Commit      struct {
-		Sha    string `json:"sha"`
-		Commit struct {
-			Author struct {
-				Name  string    `json:"name"`
-				Email string    `json:"email"`
-				Date  time.Time `json:"date"`
-			} `json:"author"`
-			Committer struct {
-				Name  string    `json:"name"`
-				Email string    `json:"email"`
-				Date  time.Time `json:"date"`
-			} `json:"committer"`
-			Message string `json:"message"`
-			Tree    struct {
-				Sha string `json:"sha"`
-				URL string `json:"url"`
-			} `json:"tree"`
-			URL          string `json:"url"`
-			CommentCount int64  `json:"comment_count"`
-		} `json:"commit"`
-		URL         string `json:"url"`
-		HTMLURL     string `json:"html_url"`
-		CommentsURL string `json:"comments_url"`
-		Author      struct {
-			Login             string `json:"login"`
-			ID                int64  `json:"id"`
-			AvatarURL         string `json:"avatar_url"`
-			GravatarID        string `json:"gravatar_id"`
-			URL               string `json:"url"`
-			HTMLURL           string `json:"html_url"`
-			FollowersURL      string `json:"followers_url"`
-			FollowingURL      string `json:"following_url"`
-			GistsURL          string `json:"gists_url"`
-			StarredURL        string `json:"starred_url"`
-			SubscriptionsURL  string `json:"subscriptions_url"`
-			OrganizationsURL  string `json:"organizations_url"`
-			ReposURL          string `json:"repos_url"`
-			EventsURL         string `json:"events_url"`
-			ReceivedEventsURL string `json:"received_events_url"`
-			Type              string `json:"type"`
-			SiteAdmin         bool   `json:"site_admin"`
-		} `json:"author"`
-		Committer struct {
-			Login             string `json:"login"`
-			ID                int64  `json:"id"`
-			AvatarURL         string `json:"avatar_url"`
-			GravatarID        string `json:"gravatar_id"`
-			URL               string `json:"url"`
-			HTMLURL           string `json:"html_url"`
-			FollowersURL      string `json:"followers_url"`
-			FollowingURL      string `json:"following_url"`
-			GistsURL          string `json:"gists_url"`
-			StarredURL        string `json:"starred_url"`
-			SubscriptionsURL  string `json:"subscriptions_url"`
-			OrganizationsURL  string `json:"organizations_url"`
-			ReposURL          string `json:"repos_url"`
-			EventsURL         string `json:"events_url"`
-			ReceivedEventsURL string `json:"received_events_url"`
-			Type              string `json:"type"`
-			SiteAdmin         bool   `json:"site_admin"`
-		} `json:"committer"`
-		Parents []Parent `json:"parents"`
-	} `json:"commit"`
-	Branches []struct {
-		Name   string `json:"name"`
-		Commit struct {
-			Sha string `json:"sha"`
-			URL string `json:"url"`
-		} `json:"commit"`
-	} `json:"branches"`
-	CreatedAt  time.Time `json:"created_at"`
-	UpdatedAt  time.Time `json:"updated_at"`
+// WatchPayload contains the information for GitHub's watch hook event
+type WatchPayload struct {
+	Action     string `json:"action"`
 	Repository struct {
 		ID       int64  `json:"id"`
 		Name     string `json:"name"`
@@ -4668,68 +7815,41 @@ type StatusPayload struct {
 		Type              string `json:"type"`
 		SiteAdmin         bool   `json:"site_admin"`
 	} `json:"sender"`
+	Installation Installation `json:"installation"`
 }
 
-// TeamPayload contains the information for GitHub's team hook event
-type TeamPayload struct {
-	Action string `json:"action"`
-	Team   struct {
-		Name            string `json:"name"`
-		ID              int64  `json:"id"`
-		Slug            string `json:"slug"`
-		Description     string `json:"description"`
-		Privacy         string `json:"privacy"`
-		URL             string `json:"url"`
-		MembersURL      string `json:"members_url"`
-		RepositoriesURL string `json:"repositories_url"`
-		Permission      string `json:"permission"`
-	} `json:"team"`
-	Organization struct {
-		Login            string `json:"login"`
-		ID               int64  `json:"id"`
-		URL              string `json:"url"`
-		ReposURL         string `json:"repos_url"`
-		EventsURL        string `json:"events_url"`
-		HooksURL         string `json:"hooks_url"`
-		IssuesURL        string `json:"issues_url"`
-		MembersURL       string `json:"members_url"`
-		PublicMembersURL string `json:"public_members_url"`
-		AvatarURL        string `json:"avatar_url"`
-		Description      string `json:"description"`
-	} `json:"organization"`
-	Sender struct {
-		Login             string `json:"login"`
-		ID                int64  `json:"id"`
-		AvatarURL         string `json:"avatar_url"`
-		GravatarID        string `json:"gravatar_id"`
-		URL               string `json:"url"`
-		HTMLURL           string `json:"html_url"`
-		FollowersURL      string `json:"followers_url"`
-		FollowingURL      string `json:"following_url"`
-		GistsURL          string `json:"gists_url"`
-		StarredURL        string `json:"starred_url"`
-		SubscriptionsURL  string `json:"subscriptions_url"`
-		OrganizationsURL  string `json:"organizations_url"`
-		ReposURL          string `json:"repos_url"`
-		EventsURL         string `json:"events_url"`
-		ReceivedEventsURL string `json:"received_events_url"`
-		Type              string `json:"type"`
-		SiteAdmin         bool   `json:"site_admin"`
-	} `json:"sender"`
-}
-
-// TeamAddPayload contains the information for GitHub's team_add hook event
-type TeamAddPayload struct {
-	Team struct {
-		Name            string `json:"name"`
-		ID              int64  `json:"id"`
-		Slug            string `json:"slug"`
-		Description     string `json:"description"`
-		Permission      string `json:"permission"`
-		URL             string `json:"url"`
-		MembersURL      string `json:"members_url"`
-		RepositoriesURL string `json:"repositories_url"`
-	} `json:"team"`
+// WorkflowJobPayload contains the information for GitHub's workflow_job hook event
+type WorkflowJobPayload struct {
+	Action      string `json:"action"`
+	WorkflowJob struct {
+		ID          int64      `json:"id"`
+		RunID       int64      `json:"run_id"`
+		RunURL      string     `json:"run_url"`
+		RunAttempt  int64      `json:"run_attempt"`
+		NodeID      string     `json:"node_id"`
+		HeadSha     string     `json:"head_sha"`
+		URL         string     `json:"url"`
+		HTMLURL     string     `json:"html_url"`
+		Status      string     `json:"status"`
+		Conclusion  *string    `json:"conclusion"`
+		StartedAt   time.Time  `json:"started_at"`
+		CompletedAt *time.Time `json:"completed_at"`
+		Name        string     `json:"name"`
+		Steps       []struct {
+			Name        string     `json:"name"`
+			Status      string     `json:"status"`
+			Conclusion  *string    `json:"conclusion"`
+			Number      int64      `json:"number"`
+			StartedAt   *time.Time `json:"started_at"`
+			CompletedAt *time.Time `json:"completed_at"`
+		} `json:"steps"`
+		CheckRunURL     string   `json:"check_run_url"`
+		Labels          []string `json:"labels"`
+		RunnerID        *int64   `json:"runner_id"`
+		RunnerName      *string  `json:"runner_name"`
+		RunnerGroupID   *int64   `json:"runner_group_id"`
+		RunnerGroupName *string  `json:"runner_group_name"`
+	} `json:"workflow_job"`
 	Repository struct {
 		ID       int64  `json:"id"`
 		Name     string `json:"name"`
@@ -4817,17 +7937,6 @@ type TeamAddPayload struct {
 		Watchers         int64     `json:"watchers"`
 		DefaultBranch    string    `json:"default_branch"`
 	} `json:"repository"`
-	Organization struct {
-		Login            string  `json:"login"`
-		ID               int64   `json:"id"`
-		URL              string  `json:"url"`
-		ReposURL         string  `json:"repos_url"`
-		EventsURL        string  `json:"events_url"`
-		MembersURL       string  `json:"members_url"`
-		PublicMembersURL string  `json:"public_members_url"`
-		AvatarURL        string  `json:"avatar_url"`
-		Description      *string `json:"description"`
-	} `json:"organization"`
 	Sender struct {
 		Login             string `json:"login"`
 		ID                int64  `json:"id"`
@@ -4847,11 +7956,77 @@ type TeamAddPayload struct {
 		Type              string `json:"type"`
 		SiteAdmin         bool   `json:"site_admin"`
 	} `json:"sender"`
+	Installation Installation `json:"installation"`
 }
 
-// WatchPayload contains the information for GitHub's watch hook event
-type WatchPayload struct {
-	Action     string `json:"action"`
+// WorkflowRunPayload contains the information for GitHub's workflow_run hook event
+type WorkflowRunPayload struct {
+	Action   string `json:"action"`
+	Workflow struct {
+		ID        int64     `json:"id"`
+		NodeID    string    `json:"node_id"`
+		Name      string    `json:"name"`
+		Path      string    `json:"path"`
+		State     string    `json:"state"`
+		CreatedAt time.Time `json:"created_at"`
+		UpdatedAt time.Time `json:"updated_at"`
+		URL       string    `json:"url"`
+		HTMLURL   string    `json:"html_url"`
+		BadgeURL  string    `json:"badge_url"`
+	} `json:"workflow"`
+	WorkflowRun struct {
+		ID            int64     `json:"id"`
+		Name          string    `json:"name"`
+		NodeID        string    `json:"node_id"`
+		HeadBranch    string    `json:"head_branch"`
+		HeadSha       string    `json:"head_sha"`
+		RunNumber     int64     `json:"run_number"`
+		RunAttempt    int64     `json:"run_attempt"`
+		Event         string    `json:"event"`
+		Status        string    `json:"status"`
+		Conclusion    *string   `json:"conclusion"`
+		WorkflowID    int64     `json:"workflow_id"`
+		CheckSuiteID  int64     `json:"check_suite_id"`
+		URL           string    `json:"url"`
+		HTMLURL       string    `json:"html_url"`
+		JobsURL       string    `json:"jobs_url"`
+		LogsURL       string    `json:"logs_url"`
+		CheckSuiteURL string    `json:"check_suite_url"`
+		ArtifactsURL  string    `json:"artifacts_url"`
+		CancelURL     string    `json:"cancel_url"`
+		RerunURL      string    `json:"rerun_url"`
+		WorkflowURL   string    `json:"workflow_url"`
+		CreatedAt     time.Time `json:"created_at"`
+		UpdatedAt     time.Time `json:"updated_at"`
+		RunStartedAt  time.Time `json:"run_started_at"`
+		PullRequests  []struct {
+			ID     int64  `json:"id"`
+			Number int64  `json:"number"`
+			URL    string `json:"url"`
+			Head   struct {
+				Ref string `json:"ref"`
+				Sha string `json:"sha"`
+			} `json:"head"`
+			Base struct {
+				Ref string `json:"ref"`
+				Sha string `json:"sha"`
+			} `json:"base"`
+		} `json:"pull_requests"`
+		HeadCommit struct {
+			ID        string    `json:"id"`
+			TreeID    string    `json:"tree_id"`
+			Message   string    `json:"message"`
+			Timestamp time.Time `json:"timestamp"`
+			Author    struct {
+				Name  string `json:"name"`
+				Email string `json:"email"`
+			} `json:"author"`
+			Committer struct {
+				Name  string `json:"name"`
+				Email string `json:"email"`
+			} `json:"committer"`
+		} `json:"head_commit"`
+	} `json:"workflow_run"`
 	Repository struct {
 		ID       int64  `json:"id"`
 		Name     string `json:"name"`
@@ -4958,6 +8133,7 @@ type WatchPayload struct {
 		Type              string `json:"type"`
 		SiteAdmin         bool   `json:"site_admin"`
 	} `json:"sender"`
+	Installation Installation `json:"installation"`
 }
 
 // Assignee contains GitHub's assignee information
@@ -5018,6 +8194,89 @@ type Milestone struct {
 	DueOn        time.Time `json:"due_on"`
 }
 
+// Changes contains the previous values of the fields an edited action changed,
+// only the fields that changed are set
+type Changes struct {
+	Title       *ChangeFrom `json:"title,omitempty"`
+	Body        *ChangeFrom `json:"body,omitempty"`
+	Name        *ChangeFrom `json:"name,omitempty"`
+	Description *ChangeFrom `json:"description,omitempty"`
+	Color       *ChangeFrom `json:"color,omitempty"`
+	DueOn       *ChangeFrom `json:"due_on,omitempty"`
+	TagName     *ChangeFrom `json:"tag_name,omitempty"`
+	// Base is set when the base branch of a pull request changed
+	Base *struct {
+		Ref ChangeFrom `json:"ref"`
+		Sha ChangeFrom `json:"sha"`
+	} `json:"base,omitempty"`
+}
+
+// ChangeFrom contains the value a field had before it was changed
+type ChangeFrom struct {
+	From string `json:"from"`
+}
+
+// IssueChanges contains the changes of an issues payload, which include where the issue
+// was transferred to or from as well as the fields an edit changed
+type IssueChanges struct {
+	Changes
+	NewIssue      *TransferredIssue      `json:"new_issue,omitempty"`
+	NewRepository *TransferredRepository `json:"new_repository,omitempty"`
+	OldIssue      *TransferredIssue      `json:"old_issue,omitempty"`
+	OldRepository *TransferredRepository `json:"old_repository,omitempty"`
+}
+
+// SponsorshipTier contains GitHub's sponsorship tier information
+type SponsorshipTier struct {
+	NodeID                string    `json:"node_id"`
+	CreatedAt             time.Time `json:"created_at"`
+	Description           string    `json:"description"`
+	MonthlyPriceInCents   int64     `json:"monthly_price_in_cents"`
+	MonthlyPriceInDollars int64     `json:"monthly_price_in_dollars"`
+	Name                  string    `json:"name"`
+	IsOneTime             bool      `json:"is_one_time"`
+	IsCustomAmount        bool      `json:"is_custom_amount"`
+}
+
+// TeamRepoPermissions contains the permissions a team has on a repository
+type TeamRepoPermissions struct {
+	Admin    bool `json:"admin"`
+	Maintain bool `json:"maintain"`
+	Push     bool `json:"push"`
+	Triage   bool `json:"triage"`
+	Pull     bool `json:"pull"`
+}
+
+// TeamChanges contains the changes of a team payload, which include the permissions the team
+// had on the repository as well as the fields of the team an edit changed
+type TeamChanges struct {
+	Changes
+	Privacy    *ChangeFrom `json:"privacy,omitempty"`
+	Repository *struct {
+		Permissions struct {
+			From TeamRepoPermissions `json:"from"`
+		} `json:"permissions"`
+	} `json:"repository,omitempty"`
+}
+
+// TransferredIssue contains the identifying information of an issue on either side of a transfer
+type TransferredIssue struct {
+	URL     string `json:"url"`
+	HTMLURL string `json:"html_url"`
+	ID      int64  `json:"id"`
+	Number  int64  `json:"number"`
+	Title   string `json:"title"`
+}
+
+// TransferredRepository contains the identifying information of a repository on either side of an issue transfer
+type TransferredRepository struct {
+	ID       int64  `json:"id"`
+	Name     string `json:"name"`
+	FullName string `json:"full_name"`
+	HTMLURL  string `json:"html_url"`
+	Private  bool   `json:"private"`
+}
+
 // MergedBy contains GitHub's merged-by information
 type MergedBy struct {
 	Login             string `json:"login"`
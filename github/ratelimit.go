@@ -0,0 +1,93 @@
+package github
+
+import (
+	"net/http"
+	"sync"
+	"time"
+)
+
+// maxIdleBuckets is the number of repositories the rate limiter tracks before sweeping
+// the buckets that have refilled, which limit nothing
+const maxIdleBuckets = 1024
+
+// WithRateLimit limits the deliveries processed for each repository to perSecond, allowing bursts
+// of up to burst deliveries, responding with a 429 to those over the limit without calling their
+// functions. The repository is only known once the payload is decoded, so deliveries over the limit
+// are still read and verified, and payloads of events that don't happen in a repository aren't limited.
+func WithRateLimit(perSecond float64, burst int) Option {
+	return func(hook *Webhook) {
+		hook.limiter = &rateLimiter{
+			rate:    perSecond,
+			burst:   float64(burst),
+			buckets: make(map[string]*bucket),
+		}
+	}
+}
+
+// rateLimiter is a token bucket per key, kept here rather than depending on x/time/rate
+type rateLimiter struct {
+	rate    float64
+	burst   float64
+	m       sync.Mutex
+	buckets map[string]*bucket
+}
+
+type bucket struct {
+	tokens float64
+	last   time.Time
+}
+
+// allow takes a token from the bucket of key, reporting false if it is empty
+func (l *rateLimiter) allow(key string, now time.Time) bool {
+	l.m.Lock()
+	defer l.m.Unlock()
+
+	b, ok := l.buckets[key]
+	if !ok {
+		if len(l.buckets) >= maxIdleBuckets {
+			l.sweep(now)
+		}
+		b = &bucket{tokens: l.burst, last: now}
+		l.buckets[key] = b
+	}
+
+	b.tokens += now.Sub(b.last).Seconds() * l.rate
+	if b.tokens > l.burst {
+		b.tokens = l.burst
+	}
+	b.last = now
+
+	if b.tokens < 1 {
+		return false
+	}
+	b.tokens--
+	return true
+}
+
+// sweep removes the buckets that have refilled by now, as a new bucket would be the same
+func (l *rateLimiter) sweep(now time.Time) {
+	for key, b := range l.buckets {
+		if b.tokens+now.Sub(b.last).Seconds()*l.rate >= l.burst {
+			delete(l.buckets, key)
+		}
+	}
+}
+
+// checkRate responds with a 429 and returns an error if the repository of the decoded payload is over the rate limit
+func (hook Webhook) checkRate(w http.ResponseWriter, event Event, pl interface{}) error {
+	if hook.limiter == nil {
+		return nil
+	}
+
+	scoped, ok := pl.(RepoScoped)
+	if !ok || len(scoped.RepoFullName()) == 0 {
+		return nil
+	}
+
+	if !hook.limiter.allow(scoped.RepoFullName(), time.Now()) {
+		err := newError(ErrRateLimited, "Webhook Event %s for %s is over the rate limit", string(event), scoped.RepoFullName())
+		http.Error(w, err.Error(), http.StatusTooManyRequests)
+		return err
+	}
+	return nil
+}
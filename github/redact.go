@@ -0,0 +1,70 @@
+package github
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+const redacted = "[REDACTED]"
+
+// defaultRedactedKeys are the keys whose values are masked in logged payloads when none are set
+var defaultRedactedKeys = []string{"token", "password", "secret", "private_key"}
+
+// WithRedactedKeys sets the JSON keys, matched case insensitively at the end of a key at any depth so
+// that token covers access_token too, whose values are masked when a payload is logged at debug level,
+// replacing the default of token, password, secret and private_key. The payload that is decoded is
+// left as is.
+func WithRedactedKeys(keys ...string) Option {
+	return func(hook *Webhook) {
+		hook.redactKeys = keys
+	}
+}
+
+// redactPayload returns the payload as logged, with the values of the redacted keys masked
+func (hook Webhook) redactPayload(payload []byte) string {
+	var v interface{}
+	if err := json.Unmarshal(payload, &v); err != nil {
+		// what isn't JSON can't be redacted, so none of it is logged
+		return fmt.Sprintf("(%d bytes, not JSON)", len(payload))
+	}
+
+	keys := hook.redactKeys
+	if keys == nil {
+		keys = defaultRedactedKeys
+	}
+
+	b, err := json.Marshal(redact(v, keys))
+	if err != nil {
+		return fmt.Sprintf("(%d bytes, not redactable)", len(payload))
+	}
+	return loggedPayload(b)
+}
+
+// redact masks the values of keys within v, which is modified in place
+func redact(v interface{}, keys []string) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, val := range v {
+			if isRedacted(k, keys) {
+				v[k] = redacted
+				continue
+			}
+			v[k] = redact(val, keys)
+		}
+	case []interface{}:
+		for i, val := range v {
+			v[i] = redact(val, keys)
+		}
+	}
+	return v
+}
+
+func isRedacted(key string, keys []string) bool {
+	for _, k := range keys {
+		if strings.HasSuffix(strings.ToLower(key), strings.ToLower(k)) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,58 @@
+package github
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/ntrv/webhooks"
+)
+
+// Replay calls the functions registered for the event with a stored delivery of it as if it had just
+// been received, for reprocessing archived deliveries without reconstructing the request. header holds
+// the headers the delivery was received with, its signature is verified against them the same way.
+// A delivery is replayed even if it was already processed, whatever its age, and without counting
+// toward the rate limit.
+func (hook Webhook) Replay(event Event, payload []byte, header webhooks.Header) error {
+	return hook.replay(event, payload, header, true)
+}
+
+// ReplayUnverified is Replay without verifying the signature of the delivery, such as for one archived
+// without its signature header or signed with a secret that has since been rotated, which is logged.
+func (hook Webhook) ReplayUnverified(event Event, payload []byte, header webhooks.Header) error {
+	return hook.replay(event, payload, header, false)
+}
+
+func (hook Webhook) replay(event Event, payload []byte, header webhooks.Header, verify bool) error {
+	w := newDiscardWriter()
+	r := &http.Request{Header: http.Header(header)}
+
+	if len(payload) == 0 {
+		return newError(ErrEmptyPayload, "Empty Payload")
+	}
+
+	if verify {
+		check := hook.newSignatureCheck(r)
+		if check != nil {
+			check.Write(payload)
+		}
+		if err := hook.verifySignature(w, check); err != nil {
+			return err
+		}
+	} else {
+		hook.log().Info(fmt.Sprintf("Skipping signature verification replaying Webhook Event %s", string(event)))
+	}
+
+	payload, err := hook.formPayload(w, r, payload)
+	if err != nil {
+		return err
+	}
+
+	meta := hook.getDeliveryMeta(event, r)
+	meta.raw = payload
+
+	// archived deliveries are old by nature, and replaying them shouldn't hold back live ones
+	hook.ageOf, hook.limiter = nil, nil
+
+	_, err = hook.dispatch(w, event, payload, meta)
+	return err
+}
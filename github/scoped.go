@@ -0,0 +1,274 @@
+package github
+
+// RepoScoped is implemented by the payloads of events that happen in a repository,
+// allowing them to be logged and routed uniformly whatever their type
+type RepoScoped interface {
+	// RepoFullName returns the owner/name of the repository the event happened in
+	RepoFullName() string
+	// SenderLogin returns the login of the user that triggered the event
+	SenderLogin() string
+}
+
+// RepoFullName returns the full name of the repository
+func (p BranchProtectionRulePayload) RepoFullName() string { return p.Repository.FullName }
+
+// SenderLogin returns the login of the sender
+func (p BranchProtectionRulePayload) SenderLogin() string { return p.Sender.Login }
+
+// RepoFullName returns the full name of the repository
+func (p CheckRunPayload) RepoFullName() string { return p.Repository.FullName }
+
+// SenderLogin returns the login of the sender
+func (p CheckRunPayload) SenderLogin() string { return p.Sender.Login }
+
+// RepoFullName returns the full name of the repository
+func (p CheckSuitePayload) RepoFullName() string { return p.Repository.FullName }
+
+// SenderLogin returns the login of the sender
+func (p CheckSuitePayload) SenderLogin() string { return p.Sender.Login }
+
+// RepoFullName returns the full name of the repository
+func (p CommitCommentPayload) RepoFullName() string { return p.Repository.FullName }
+
+// SenderLogin returns the login of the sender
+func (p CommitCommentPayload) SenderLogin() string { return p.Sender.Login }
+
+// RepoFullName returns the full name of the repository
+func (p CreatePayload) RepoFullName() string { return p.Repository.FullName }
+
+// SenderLogin returns the login of the sender
+func (p CreatePayload) SenderLogin() string { return p.Sender.Login }
+
+// RepoFullName returns the full name of the repository
+func (p DeletePayload) RepoFullName() string { return p.Repository.FullName }
+
+// SenderLogin returns the login of the sender
+func (p DeletePayload) SenderLogin() string { return p.Sender.Login }
+
+// RepoFullName returns the full name of the repository
+func (p DeploymentPayload) RepoFullName() string { return p.Repository.FullName }
+
+// SenderLogin returns the login of the sender
+func (p DeploymentPayload) SenderLogin() string { return p.Sender.Login }
+
+// RepoFullName returns the full name of the repository
+func (p DeploymentProtectionRulePayload) RepoFullName() string { return p.Repository.FullName }
+
+// SenderLogin returns the login of the sender
+func (p DeploymentProtectionRulePayload) SenderLogin() string { return p.Sender.Login }
+
+// RepoFullName returns the full name of the repository
+func (p DeploymentReviewPayload) RepoFullName() string { return p.Repository.FullName }
+
+// SenderLogin returns the login of the sender
+func (p DeploymentReviewPayload) SenderLogin() string { return p.Sender.Login }
+
+// RepoFullName returns the full name of the repository
+func (p DeploymentStatusPayload) RepoFullName() string { return p.Repository.FullName }
+
+// SenderLogin returns the login of the sender
+func (p DeploymentStatusPayload) SenderLogin() string { return p.Sender.Login }
+
+// RepoFullName returns the full name of the repository
+func (p DiscussionPayload) RepoFullName() string { return p.Repository.FullName }
+
+// SenderLogin returns the login of the sender
+func (p DiscussionPayload) SenderLogin() string { return p.Sender.Login }
+
+// RepoFullName returns the full name of the repository
+func (p DiscussionCommentPayload) RepoFullName() string { return p.Repository.FullName }
+
+// SenderLogin returns the login of the sender
+func (p DiscussionCommentPayload) SenderLogin() string { return p.Sender.Login }
+
+// RepoFullName returns the full name of the repository
+func (p ForkPayload) RepoFullName() string { return p.Repository.FullName }
+
+// SenderLogin returns the login of the sender
+func (p ForkPayload) SenderLogin() string { return p.Sender.Login }
+
+// RepoFullName returns the full name of the repository
+func (p GollumPayload) RepoFullName() string { return p.Repository.FullName }
+
+// SenderLogin returns the login of the sender
+func (p GollumPayload) SenderLogin() string { return p.Sender.Login }
+
+// RepoFullName returns the full name of the repository
+func (p IssueCommentPayload) RepoFullName() string { return p.Repository.FullName }
+
+// SenderLogin returns the login of the sender
+func (p IssueCommentPayload) SenderLogin() string { return p.Sender.Login }
+
+// RepoFullName returns the full name of the repository
+func (p IssuesPayload) RepoFullName() string { return p.Repository.FullName }
+
+// SenderLogin returns the login of the sender
+func (p IssuesPayload) SenderLogin() string { return p.Sender.Login }
+
+// RepoFullName returns the full name of the repository
+func (p LabelPayload) RepoFullName() string { return p.Repository.FullName }
+
+// SenderLogin returns the login of the sender
+func (p LabelPayload) SenderLogin() string { return p.Sender.Login }
+
+// RepoFullName returns the full name of the repository
+func (p MemberPayload) RepoFullName() string { return p.Repository.FullName }
+
+// SenderLogin returns the login of the sender
+func (p MemberPayload) SenderLogin() string { return p.Sender.Login }
+
+// RepoFullName returns the full name of the repository
+func (p MergeGroupPayload) RepoFullName() string { return p.Repository.FullName }
+
+// SenderLogin returns the login of the sender
+func (p MergeGroupPayload) SenderLogin() string { return p.Sender.Login }
+
+// RepoFullName returns the full name of the repository
+func (p MilestonePayload) RepoFullName() string { return p.Repository.FullName }
+
+// SenderLogin returns the login of the sender
+func (p MilestonePayload) SenderLogin() string { return p.Sender.Login }
+
+// RepoFullName returns the full name of the repository
+func (p PackagePayload) RepoFullName() string { return p.Repository.FullName }
+
+// SenderLogin returns the login of the sender
+func (p PackagePayload) SenderLogin() string { return p.Sender.Login }
+
+// RepoFullName returns the full name of the repository
+func (p PageBuildPayload) RepoFullName() string { return p.Repository.FullName }
+
+// SenderLogin returns the login of the sender
+func (p PageBuildPayload) SenderLogin() string { return p.Sender.Login }
+
+// RepoFullName returns the full name of the repository
+func (p ProjectCardPayload) RepoFullName() string { return p.Repository.FullName }
+
+// SenderLogin returns the login of the sender
+func (p ProjectCardPayload) SenderLogin() string { return p.Sender.Login }
+
+// RepoFullName returns the full name of the repository
+func (p ProjectColumnPayload) RepoFullName() string { return p.Repository.FullName }
+
+// SenderLogin returns the login of the sender
+func (p ProjectColumnPayload) SenderLogin() string { return p.Sender.Login }
+
+// RepoFullName returns the full name of the repository
+func (p ProjectPayload) RepoFullName() string { return p.Repository.FullName }
+
+// SenderLogin returns the login of the sender
+func (p ProjectPayload) SenderLogin() string { return p.Sender.Login }
+
+// RepoFullName returns the full name of the repository
+func (p PublicPayload) RepoFullName() string { return p.Repository.FullName }
+
+// SenderLogin returns the login of the sender
+func (p PublicPayload) SenderLogin() string { return p.Sender.Login }
+
+// RepoFullName returns the full name of the repository
+func (p PullRequestPayload) RepoFullName() string { return p.Repository.FullName }
+
+// SenderLogin returns the login of the sender
+func (p PullRequestPayload) SenderLogin() string { return p.Sender.Login }
+
+// RepoFullName returns the full name of the repository
+func (p PullRequestReviewPayload) RepoFullName() string { return p.Repository.FullName }
+
+// SenderLogin returns the login of the sender
+func (p PullRequestReviewPayload) SenderLogin() string { return p.Sender.Login }
+
+// RepoFullName returns the full name of the repository
+func (p PullRequestReviewCommentPayload) RepoFullName() string { return p.Repository.FullName }
+
+// SenderLogin returns the login of the sender
+func (p PullRequestReviewCommentPayload) SenderLogin() string { return p.Sender.Login }
+
+// RepoFullName returns the full name of the repository
+func (p PullRequestReviewThreadPayload) RepoFullName() string { return p.Repository.FullName }
+
+// SenderLogin returns the login of the sender
+func (p PullRequestReviewThreadPayload) SenderLogin() string { return p.Sender.Login }
+
+// RepoFullName returns the full name of the repository
+func (p PushPayload) RepoFullName() string { return p.Repository.FullName }
+
+// SenderLogin returns the login of the sender
+func (p PushPayload) SenderLogin() string { return p.Sender.Login }
+
+// RepoFullName returns the full name of the repository
+func (p ReleasePayload) RepoFullName() string { return p.Repository.FullName }
+
+// SenderLogin returns the login of the sender
+func (p ReleasePayload) SenderLogin() string { return p.Sender.Login }
+
+// RepoFullName returns the full name of the repository
+func (p RepositoryPayload) RepoFullName() string { return p.Repository.FullName }
+
+// SenderLogin returns the login of the sender
+func (p RepositoryPayload) SenderLogin() string { return p.Sender.Login }
+
+// RepoFullName returns the full name of the repository
+func (p RepositoryDispatchPayload) RepoFullName() string { return p.Repository.FullName }
+
+// SenderLogin returns the login of the sender
+func (p RepositoryDispatchPayload) SenderLogin() string { return p.Sender.Login }
+
+// RepoFullName returns the full name of the repository
+func (p SecretScanningAlertPayload) RepoFullName() string { return p.Repository.FullName }
+
+// SenderLogin returns the login of the sender
+func (p SecretScanningAlertPayload) SenderLogin() string { return p.Sender.Login }
+
+// RepoFullName returns the full name of the repository
+func (p SecretScanningAlertLocationPayload) RepoFullName() string { return p.Repository.FullName }
+
+// SenderLogin returns the login of the sender
+func (p SecretScanningAlertLocationPayload) SenderLogin() string { return p.Sender.Login }
+
+// RepoFullName returns the full name of the repository
+func (p StarPayload) RepoFullName() string { return p.Repository.FullName }
+
+// SenderLogin returns the login of the sender
+func (p StarPayload) SenderLogin() string { return p.Sender.Login }
+
+// RepoFullName returns the full name of the repository
+func (p StatusPayload) RepoFullName() string { return p.Repository.FullName }
+
+// SenderLogin returns the login of the sender
+func (p StatusPayload) SenderLogin() string { return p.Sender.Login }
+
+// RepoFullName returns the full name of the repository, which is empty for the actions that
+// don't involve one
+func (p TeamPayload) RepoFullName() string {
+	if p.Repository == nil {
+		return ""
+	}
+	return p.Repository.FullName
+}
+
+// SenderLogin returns the login of the sender
+func (p TeamPayload) SenderLogin() string { return p.Sender.Login }
+
+// RepoFullName returns the full name of the repository
+func (p TeamAddPayload) RepoFullName() string { return p.Repository.FullName }
+
+// SenderLogin returns the login of the sender
+func (p TeamAddPayload) SenderLogin() string { return p.Sender.Login }
+
+// RepoFullName returns the full name of the repository
+func (p WatchPayload) RepoFullName() string { return p.Repository.FullName }
+
+// SenderLogin returns the login of the sender
+func (p WatchPayload) SenderLogin() string { return p.Sender.Login }
+
+// RepoFullName returns the full name of the repository
+func (p WorkflowJobPayload) RepoFullName() string { return p.Repository.FullName }
+
+// SenderLogin returns the login of the sender
+func (p WorkflowJobPayload) SenderLogin() string { return p.Sender.Login }
+
+// RepoFullName returns the full name of the repository
+func (p WorkflowRunPayload) RepoFullName() string { return p.Repository.FullName }
+
+// SenderLogin returns the login of the sender
+func (p WorkflowRunPayload) SenderLogin() string { return p.Sender.Login }
@@ -0,0 +1,145 @@
+package github
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"time"
+)
+
+// WithStreaming decodes the payloads of registered events straight from the request body,
+// computing their HMAC as they are read, rather than reading them into memory first.
+// The payload is still only handed to the registered functions once its signature is verified.
+func WithStreaming() Option {
+	return func(hook *Webhook) {
+		hook.stream = true
+	}
+}
+
+// countingReader counts the bytes read from r and records the first error other than io.EOF
+type countingReader struct {
+	r   io.Reader
+	n   int64
+	err error
+}
+
+func (cr *countingReader) Read(p []byte) (int, error) {
+	n, err := cr.r.Read(p)
+	cr.n += int64(n)
+	if err != nil && err != io.EOF && cr.err == nil {
+		cr.err = err
+	}
+	return n, err
+}
+
+// decodeStream decodes the payload of the given event read from r into the payload type
+// this Webhook instance uses for it
+func (hook Webhook) decodeStream(event Event, r io.Reader) (interface{}, error) {
+	if target, ok := hook.target(event); ok {
+		v := target()
+		err := hook.newDecoder(r).Decode(v)
+		return v, err
+	}
+
+	t, err := hook.payloadTypeOf(event)
+	if err != nil {
+		return nil, err
+	}
+
+	v := reflect.New(t)
+	err = hook.newDecoder(r).Decode(v.Interface())
+	return v.Elem().Interface(), err
+}
+
+// streamPayload decodes and verifies the payload of a registered event while reading it
+func (hook Webhook) streamPayload(w http.ResponseWriter, r *http.Request, event Event, handlers []handler) (Outcome, error) {
+	rb, err := hook.requestBody(w, r)
+	if err != nil {
+		return OutcomeReadError, err
+	}
+
+	// read one byte past the limit to know whether it was exceeded
+	cr := &countingReader{r: io.LimitReader(rb, hook.maxBody+1)}
+
+	var body io.Reader = cr
+	check := hook.newSignatureCheck(r)
+	if check != nil {
+		body = io.TeeReader(cr, check)
+	}
+
+	decodeStart := time.Now()
+	pl, decodeErr := hook.decodeStream(event, body)
+	hook.recordDecode(decodeStart)
+
+	// whatever the decoder left unread is still part of the signed payload
+	if _, err := io.Copy(ioutil.Discard, body); err != nil && cr.err == nil {
+		cr.err = err
+	}
+
+	if err := hook.checkRead(w, cr.err, cr.n); err != nil {
+		return OutcomeReadError, err
+	}
+	if err := hook.drainBody(w, r, cr.n); err != nil {
+		return OutcomeReadError, err
+	}
+
+	if check != nil {
+		if err := hook.checkSignature(w, check); err != nil {
+			hook.log().Debug(err.Error())
+			return OutcomeSignatureError, err
+		}
+	}
+
+	meta := hook.getDeliveryMeta(event, r)
+
+	if hook.isDuplicate(meta) {
+		return OutcomeDuplicate, nil
+	}
+
+	outcome, err := hook.dispatchStream(w, event, handlers, pl, decodeErr, meta)
+	if err != nil {
+		hook.forgetDelivery(meta)
+	}
+	return outcome, err
+}
+
+// dispatchStream checks the streamed payload of the event, which failed decoding if decodeErr is set,
+// and calls the functions registered for it
+func (hook Webhook) dispatchStream(w http.ResponseWriter, event Event, handlers []handler, pl interface{}, decodeErr error, meta DeliveryMeta) (Outcome, error) {
+	if decodeErr != nil {
+		err := fmt.Errorf("Issue decoding %s Payload: %w", string(event), decodeErr)
+		hook.log().Error(err.Error())
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return OutcomeDecodeError, err
+	}
+
+	if err := hook.checkPayload(w, event, pl); err != nil {
+		hook.log().Info(err.Error())
+		return OutcomeInvalidPayload, err
+	}
+
+	if err := hook.checkAge(w, event, pl); err != nil {
+		hook.log().Info(err.Error())
+		return OutcomeTooOld, err
+	}
+
+	if err := hook.checkRate(w, event, pl); err != nil {
+		hook.log().Info(err.Error())
+		return OutcomeRateLimited, err
+	}
+
+	// the payload has to be decoded before its action is known
+	var action string
+	if isFiltered(handlers) {
+		action = payloadAction(pl)
+	}
+	fns := matchHandlers(handlers, action)
+	if len(fns) == 0 {
+		hook.logRequest(fmt.Sprintf("Webhook Event %s action %s not registered", string(event), action))
+		return OutcomeFiltered, nil
+	}
+
+	return hook.runProcessPayloadFuncs(w, fns, pl, meta)
+}
@@ -0,0 +1,56 @@
+package github
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"reflect"
+)
+
+// WithStrictDecoding rejects payloads with fields their payload type doesn't have as failing to decode,
+// to catch fields GitHub adds that the payload types don't model yet, such as when testing. Payloads
+// are decoded leniently by default, which ignores such fields.
+func WithStrictDecoding(enabled bool) Option {
+	return func(hook *Webhook) {
+		hook.strict = enabled
+	}
+}
+
+// newDecoder returns a json.Decoder reading from r, disallowing unknown fields when decoding strictly
+func (hook Webhook) newDecoder(r io.Reader) *json.Decoder {
+	dec := json.NewDecoder(r)
+	if hook.strict {
+		dec.DisallowUnknownFields()
+	}
+	return dec
+}
+
+// unmarshal decodes payload into v as json.Unmarshal does, disallowing unknown fields when decoding strictly
+func (hook Webhook) unmarshal(payload []byte, v interface{}) error {
+	if !hook.strict {
+		return json.Unmarshal(payload, v)
+	}
+
+	dec := hook.newDecoder(bytes.NewReader(payload))
+	if err := dec.Decode(v); err != nil {
+		return err
+	}
+	if len(bytes.TrimSpace(payload[dec.InputOffset():])) > 0 {
+		return errors.New("invalid character after top-level value")
+	}
+	return nil
+}
+
+// decodeStrict decodes the payload of the given event into the payload type this Webhook instance
+// uses for it, disallowing unknown fields
+func (hook Webhook) decodeStrict(event Event, payload []byte) (interface{}, error) {
+	t, err := hook.payloadTypeOf(event)
+	if err != nil {
+		return nil, err
+	}
+
+	v := reflect.New(t)
+	err = hook.unmarshal(payload, v.Interface())
+	return v.Elem().Interface(), err
+}
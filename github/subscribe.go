@@ -0,0 +1,108 @@
+package github
+
+import (
+	"fmt"
+	"sync"
+)
+
+// defaultSubscriberBuffer is the number of payloads a subscriber can fall behind by before
+// the oldest are dropped
+const defaultSubscriberBuffer = 64
+
+// WithSubscriberBuffer sets the number of payloads each channel returned by Subscribe buffers,
+// once a subscriber falls that far behind the oldest payload is dropped for each new one.
+func WithSubscriberBuffer(n int) Option {
+	return func(hook *Webhook) {
+		hook.subBuffer = n
+	}
+}
+
+// subscriber is a channel receiving payloads, which is closed once it unsubscribes
+type subscriber struct {
+	ch chan interface{}
+	// m guards closed, which is set once ch is closed so that nothing is published to it after
+	m      sync.Mutex
+	closed bool
+}
+
+// Subscribe returns a channel receiving the decoded payload of each delivery of the specified event,
+// for consumers that would rather receive than be called, along with the function to call to stop
+// receiving, which closes the channel. Every subscriber receives every payload, publishing to a
+// subscriber that has fallen behind drops its oldest payload rather than blocking the delivery,
+// see WithSubscriberBuffer. The channel is also closed when the event is deregistered.
+func (hook Webhook) Subscribe(event Event) (<-chan interface{}, func(), error) {
+	if err := checkEvents(event); err != nil {
+		return nil, nil, err
+	}
+
+	sub := &subscriber{ch: make(chan interface{}, hook.subBuffer)}
+	fn := func(payload interface{}, meta DeliveryMeta) error {
+		hook.publish(sub, payload, meta.Event)
+		return nil
+	}
+
+	hook.mu.Lock()
+	hook.eventFuncs[event] = append(hook.eventFuncs[event], handler{fn: fn, sub: sub})
+	hook.mu.Unlock()
+
+	return sub.ch, func() { hook.unsubscribe(event, sub) }, nil
+}
+
+// unsubscribe removes the function publishing to the subscriber of the event and closes its channel
+func (hook Webhook) unsubscribe(event Event, sub *subscriber) {
+	hook.mu.Lock()
+	defer hook.mu.Unlock()
+
+	handlers := hook.eventFuncs[event]
+	for i, h := range handlers {
+		if h.sub != sub {
+			continue
+		}
+
+		// the slice is copied, as deliveries being processed may still be using it
+		handlers = append(append([]handler{}, handlers[:i]...), handlers[i+1:]...)
+		if len(handlers) == 0 {
+			delete(hook.eventFuncs, event)
+		} else {
+			hook.eventFuncs[event] = handlers
+		}
+		break
+	}
+	sub.close()
+}
+
+// close closes the channel of the subscriber, unless it already is
+func (sub *subscriber) close() {
+	sub.m.Lock()
+	defer sub.m.Unlock()
+
+	if !sub.closed {
+		sub.closed = true
+		close(sub.ch)
+	}
+}
+
+// publish sends the payload to the subscriber without blocking, dropping its oldest payload while full
+func (hook Webhook) publish(sub *subscriber, payload interface{}, event Event) {
+	sub.m.Lock()
+	defer sub.m.Unlock()
+
+	// a delivery being processed when the subscriber unsubscribed may still publish to it
+	if sub.closed {
+		return
+	}
+
+	for {
+		select {
+		case sub.ch <- payload:
+			return
+		default:
+		}
+
+		select {
+		case <-sub.ch:
+			hook.log().Debug(fmt.Sprintf("Subscriber of Webhook Event %s fell behind, dropping its oldest payload", string(event)))
+		default:
+		}
+	}
+}
@@ -0,0 +1,56 @@
+//go:build ignore
+
+// fixture writes a captured GitHub delivery body to testdata/<event>.json,
+// indented the way the existing fixtures are. Run it from the github
+// directory:
+//
+//	go run testdata/fixture.go push delivery.json
+//
+// Pass "-" as the file to read the body from stdin.
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+)
+
+func main() {
+	if len(os.Args) != 3 {
+		fmt.Fprintln(os.Stderr, "usage: go run testdata/fixture.go <event> <file|->")
+		os.Exit(2)
+	}
+
+	event, file := os.Args[1], os.Args[2]
+
+	var (
+		body []byte
+		err  error
+	)
+	if file == "-" {
+		body, err = ioutil.ReadAll(os.Stdin)
+	} else {
+		body, err = ioutil.ReadFile(file)
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	var out bytes.Buffer
+	if err = json.Indent(&out, bytes.TrimSpace(body), "", "  "); err != nil {
+		fmt.Fprintf(os.Stderr, "%s is not valid JSON: %s\n", file, err)
+		os.Exit(1)
+	}
+	out.WriteByte('\n')
+
+	dst := filepath.Join("testdata", event+".json")
+	if err = ioutil.WriteFile(dst, out.Bytes(), 0644); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	fmt.Println("wrote", dst)
+}
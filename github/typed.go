@@ -0,0 +1,65 @@
+package github
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/ntrv/webhooks"
+)
+
+// RegisterInto registers the function to call when the specified event is encountered, with its payload
+// decoded into the value target returns instead of the built-in payload type, such as a pointer to a
+// struct holding only the fields the function uses. target is called for every delivery of the event
+// and must return a fresh value json.Unmarshal can decode into, which functions already registered
+// for the event receive as well, those registered with RegisterTyped failing as the value isn't a T.
+func (hook Webhook) RegisterInto(event Event, target func() interface{}, fn webhooks.ProcessPayloadFunc) error {
+	if err := checkEvents(event); err != nil {
+		return err
+	}
+
+	hook.mu.Lock()
+	hook.targets[event] = target
+	hook.mu.Unlock()
+
+	return hook.RegisterEvents(fn, event)
+}
+
+// target returns the function returning the value to decode the payload of the event into,
+// if one was registered with RegisterInto
+func (hook Webhook) target(event Event) (func() interface{}, bool) {
+	hook.mu.RLock()
+	defer hook.mu.RUnlock()
+
+	target, ok := hook.targets[event]
+	return target, ok
+}
+
+// RegisterTyped registers the function to call with the decoded payload when the specified event
+// is encountered, returning an error if T is not the payload type of the event. A delivery whose
+// payload isn't a T, as a type was registered for the event with RegisterInto since, fails with
+// an error without calling fn.
+func RegisterTyped[T any](hook *Webhook, event Event, fn func(T, webhooks.Header) error) error {
+	t, err := hook.payloadTypeOf(event)
+	if err != nil {
+		return err
+	}
+	if target, ok := hook.target(event); ok {
+		t = reflect.TypeOf(target())
+	}
+	if !t.AssignableTo(reflect.TypeOf((*T)(nil)).Elem()) {
+		return typeMismatch[T](event, t)
+	}
+
+	return hook.RegisterDeliveryEvents(func(payload interface{}, meta DeliveryMeta) error {
+		pl, ok := payload.(T)
+		if !ok {
+			return typeMismatch[T](event, reflect.TypeOf(payload))
+		}
+		return fn(pl, meta.Header)
+	}, event)
+}
+
+// typeMismatch returns the error for a function expecting a T being given a payload of type t for the event
+func typeMismatch[T any](event Event, t reflect.Type) error {
+	return fmt.Errorf("Webhook Event %s expects payload type %s, not %s", string(event), t, reflect.TypeOf((*T)(nil)).Elem())
+}
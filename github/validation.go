@@ -0,0 +1,28 @@
+package github
+
+import (
+	"net/http"
+)
+
+// WithValidation checks each decoded payload with fn before its functions are called, such as for
+// the fields they require, responding with a 400 without calling them if fn returns an error.
+// Payloads of events unknown to this package are left raw and aren't checked.
+func WithValidation(fn func(event Event, payload interface{}) error) Option {
+	return func(hook *Webhook) {
+		hook.validator = fn
+	}
+}
+
+// checkPayload responds with a 400 and returns an error if the decoded payload fails validation
+func (hook Webhook) checkPayload(w http.ResponseWriter, event Event, pl interface{}) error {
+	if hook.validator == nil {
+		return nil
+	}
+
+	if err := hook.validator(event, pl); err != nil {
+		err = newError(ErrInvalidPayload, "Webhook Event %s payload is invalid: %s", string(event), err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return err
+	}
+	return nil
+}
@@ -1,6 +1,10 @@
 package webhooks
 
-import "log"
+import (
+	"fmt"
+	"log"
+	"strings"
+)
 
 // DefaultLog contains the default logger for webhooks, and prints only info and error messages by default
 // for debugs override DefaultLog or see NewLogger for creating one without debugs.
@@ -16,6 +20,104 @@ type Logger interface {
 	Debug(string)
 }
 
+// FieldLogger is a Logger that can attach structured key/value pairs to its messages.
+type FieldLogger interface {
+	Logger
+	// With returns a Logger that includes keyvals with every message.
+	With(keyvals ...interface{}) Logger
+}
+
+// WithFields returns a Logger that includes keyvals with every message when l
+// is a FieldLogger, otherwise l is returned as is.
+func WithFields(l Logger, keyvals ...interface{}) Logger {
+	if fl, ok := l.(FieldLogger); ok {
+		return fl.With(keyvals...)
+	}
+	return l
+}
+
+// DebugLogger is a Logger that can tell whether it prints debug messages, so they aren't built for nothing.
+type DebugLogger interface {
+	Logger
+	// DebugEnabled reports whether Debug prints the messages it is given.
+	DebugEnabled() bool
+}
+
+// DebugEnabled reports whether l prints debug messages when l is a DebugLogger,
+// otherwise l is assumed to print them.
+func DebugEnabled(l Logger) bool {
+	if dl, ok := l.(DebugLogger); ok {
+		return dl.DebugEnabled()
+	}
+	return true
+}
+
+// Level is the severity of a log message
+type Level int
+
+// log levels, from least to most severe
+const (
+	LevelDebug Level = iota
+	LevelInfo
+	LevelError
+)
+
+// ParseLevel returns the level with the given name, one of debug, info or error,
+// so the level can be taken from configuration.
+func ParseLevel(name string) (Level, error) {
+	switch strings.ToLower(name) {
+	case "debug":
+		return LevelDebug, nil
+	case "info":
+		return LevelInfo, nil
+	case "error":
+		return LevelError, nil
+	}
+	return LevelDebug, fmt.Errorf("Unknown log level %s", name)
+}
+
+// WithLevel returns a Logger that only passes the messages of at least level on to l,
+// keeping any fields attached to it afterwards.
+func WithLevel(l Logger, level Level) FieldLogger {
+	return &levelLogger{l: l, level: level}
+}
+
+type levelLogger struct {
+	l     Logger
+	level Level
+}
+
+// Info prints basic information.
+func (l *levelLogger) Info(msg string) {
+	if l.level <= LevelInfo {
+		l.l.Info(msg)
+	}
+}
+
+// Error prints error information.
+func (l *levelLogger) Error(msg string) {
+	if l.level <= LevelError {
+		l.l.Error(msg)
+	}
+}
+
+// Debug prints information usefull for debugging.
+func (l *levelLogger) Debug(msg string) {
+	if l.level <= LevelDebug {
+		l.l.Debug(msg)
+	}
+}
+
+// DebugEnabled reports whether Debug prints the messages it is given.
+func (l *levelLogger) DebugEnabled() bool {
+	return l.level <= LevelDebug && DebugEnabled(l.l)
+}
+
+// With returns a Logger that includes keyvals with every message.
+func (l *levelLogger) With(keyvals ...interface{}) Logger {
+	return &levelLogger{l: WithFields(l.l, keyvals...), level: l.level}
+}
+
 // NewLogger returns a new logger for use.
 func NewLogger(debug bool) Logger {
 	return &logger{PrintDebugs: debug}
@@ -42,3 +144,8 @@ func (l *logger) Debug(msg string) {
 	}
 	log.Println("DEBUG:", msg)
 }
+
+// DebugEnabled reports whether Debug prints the messages it is given.
+func (l *logger) DebugEnabled() bool {
+	return l.PrintDebugs
+}
@@ -0,0 +1,40 @@
+package webhooks
+
+import (
+	"context"
+	"log/slog"
+)
+
+// NewSlogLogger returns a FieldLogger that writes to l, passing fields through as slog attributes.
+func NewSlogLogger(l *slog.Logger) FieldLogger {
+	return &slogLogger{l: l}
+}
+
+type slogLogger struct {
+	l *slog.Logger
+}
+
+// Info prints basic information.
+func (s *slogLogger) Info(msg string) {
+	s.l.Info(msg)
+}
+
+// Error prints error information.
+func (s *slogLogger) Error(msg string) {
+	s.l.Error(msg)
+}
+
+// Debug prints information usefull for debugging.
+func (s *slogLogger) Debug(msg string) {
+	s.l.Debug(msg)
+}
+
+// DebugEnabled reports whether Debug prints the messages it is given.
+func (s *slogLogger) DebugEnabled() bool {
+	return s.l.Enabled(context.Background(), slog.LevelDebug)
+}
+
+// With returns a Logger that includes keyvals with every message.
+func (s *slogLogger) With(keyvals ...interface{}) Logger {
+	return &slogLogger{l: s.l.With(keyvals...)}
+}
@@ -1,6 +1,7 @@
 package webhooks
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 )
@@ -46,6 +47,10 @@ type server struct {
 // ProcessPayloadFunc is a common function for payload return values
 type ProcessPayloadFunc func(payload interface{}, header Header)
 
+// ErrDropDelivery can be returned, or wrapped, by handlers that return errors to acknowledge
+// a delivery that failed to process, so that it is not retried.
+var ErrDropDelivery = errors.New("Delivery dropped")
+
 // Handler returns the webhook http.Handler for use in your own Mux implementation
 func Handler(hook Webhook) http.Handler {
 	return &server{
@@ -3,8 +3,10 @@ package webhooks
 import (
 	"bytes"
 	"crypto/tls"
+	"log/slog"
 	"net/http"
 	"os"
+	"strings"
 	"testing"
 	"time"
 
@@ -278,3 +280,36 @@ func TestProviderString(t *testing.T) {
 	Equal(t, GitLab.String(), "GitLab")
 	Equal(t, Provider(999999).String(), "Unknown")
 }
+
+func TestSlogLogger(t *testing.T) {
+	var buf bytes.Buffer
+	l := NewSlogLogger(slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug})))
+
+	WithFields(l, "event", "push").Info("received")
+	Equal(t, strings.Contains(buf.String(), "msg=received event=push"), true)
+
+	plain := NewLogger(false)
+	Equal(t, WithFields(plain, "event", "push"), plain)
+}
+
+func TestLevelLogger(t *testing.T) {
+	var buf bytes.Buffer
+	l := WithLevel(NewSlogLogger(slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))), LevelInfo)
+
+	l.Debug("payload")
+	Equal(t, buf.Len(), 0)
+	Equal(t, DebugEnabled(l), false)
+	Equal(t, DebugEnabled(WithLevel(NewLogger(true), LevelDebug)), true)
+	Equal(t, DebugEnabled(NewLogger(false)), false)
+	Equal(t, DebugEnabled(NewSlogLogger(slog.New(slog.NewTextHandler(&buf, nil)))), false)
+
+	WithFields(l, "event", "push").Info("received")
+	Equal(t, strings.Contains(buf.String(), "msg=received event=push"), true)
+
+	level, err := ParseLevel("ERROR")
+	Equal(t, err, nil)
+	Equal(t, level, LevelError)
+
+	_, err = ParseLevel("verbose")
+	NotEqual(t, err, nil)
+}